@@ -0,0 +1,61 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+)
+
+// collectorKey is the context key used to store the per-call error collector.
+type collectorKey struct{}
+
+// errorCollector captures the first internal error reported by validation functions during a single validation call.
+// It also keeps the message parameters captured by validation functions, to be merged on translation of the failure.
+type errorCollector struct {
+	err    error
+	extras map[extraKey]map[string]any
+}
+
+// extraKey identifies the failure of a rule on a value.
+type extraKey struct {
+	rule, param, value string
+}
+
+// newCollectorContext returns a child context carrying a new error collector.
+func newCollectorContext(ctx context.Context) (context.Context, *errorCollector) {
+	c := &errorCollector{}
+	return context.WithValue(ctx, collectorKey{}, c), c
+}
+
+// collect records the error on the collector stored in context, if any.
+// Only the first reported error is kept.
+func collect(ctx context.Context, err error) {
+	if c, ok := ctx.Value(collectorKey{}).(*errorCollector); ok && c.err == nil {
+		c.err = err
+	}
+}
+
+// collectExtra records the message parameters of the rule failure on the collector stored in context, if any.
+func collectExtra(ctx context.Context, rule, param string, value any, extra map[string]any) {
+	if c, ok := ctx.Value(collectorKey{}).(*errorCollector); ok {
+		if c.extras == nil {
+			c.extras = make(map[extraKey]map[string]any)
+		}
+		c.extras[extraKey{rule, param, fmt.Sprint(value)}] = extra
+	}
+}
+
+// extra returns the message parameters collected for the rule failure on the value.
+func (c *errorCollector) extra(rule, param string, value any) map[string]any {
+	if c == nil || c.extras == nil {
+		return nil
+	}
+	return c.extras[extraKey{rule, param, fmt.Sprint(value)}]
+}
+
+// resolve returns the collected internal error if any, otherwise the validation error.
+func (c *errorCollector) resolve(err error) error {
+	if c.err != nil {
+		return c.err
+	}
+	return err
+}
@@ -1,25 +1,192 @@
 package govalidator
 
 import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mekramy/goi18n"
 )
 
+// I18nValidator implements Validator on top of go-playground validator and goi18n translator.
+// Validation methods are safe for concurrent use, internal errors are collected per call through context.
+// Registration methods (AddValidation, AddValidationWithError and options) mutate the underlying
+// validator and must complete before the validator is shared between goroutines.
 type I18nValidator struct {
-	prefix     string
-	translator goi18n.Translator
-	validator  *validator.Validate
+	settings
+	validator *validator.Validate
+	// registrations records engine registrations to be replayed by Clone
+	registrations []func(iv *I18nValidator, engine *validator.Validate)
+	// tagNameFunc resolves field names registered by WithTagResolver, used to report struct level errors
+	tagNameFunc validator.TagNameFunc
+	extractors  map[string]func(param string, value any) map[string]any
+	// fieldRefs resolves the referenced field name from the parameter of field reference rules
+	fieldRefs map[string]func(param string) string
+	locales   map[string]struct{}
+	mutex     sync.RWMutex
+}
+
+// settings holds the plain configuration of I18nValidator, copied as is by Clone.
+type settings struct {
+	prefix          string
+	translator      goi18n.Translator
+	keyMode         ErrorKeyMode
+	trimSpace       bool
+	messageTag      string
+	bail            bool
+	observer        func(kind string, d time.Duration, errCount int)
+	numberFormatter func(locale string, n any) string
+	skipInterfaces  bool
+	// internalTranslator translates internal errors for ValidationError.InternalMessage
+	internalTranslator func(locale string, err error) string
 }
 
 func (v *I18nValidator) AddValidation(rule string, f validator.Func) {
+	v.addValidation(rule, func(_ *I18nValidator, fl validator.FieldLevel) bool {
+		return f(fl)
+	})
+}
+
+// addValidation registers a validation function receiving the validator it is bound to, so Clone rebinds it to the copy.
+func (v *I18nValidator) addValidation(rule string, f func(iv *I18nValidator, fl validator.FieldLevel) bool) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return
+	}
+
+	v.register(func(iv *I18nValidator, engine *validator.Validate) {
+		engine.RegisterValidation(rule, func(fl validator.FieldLevel) bool {
+			return f(iv, iv.fieldLevel(fl))
+		})
+	})
+}
+
+func (v *I18nValidator) AddValidations(rules map[string]validator.Func) {
+	for rule, f := range rules {
+		v.AddValidation(rule, f)
+	}
+}
+
+func (v *I18nValidator) AddValidationCtx(rule string, f validator.FuncCtx) {
 	rule = strings.TrimSpace(rule)
 	if rule == "" {
 		return
 	}
 
-	v.validator.RegisterValidation(rule, f)
+	v.register(func(iv *I18nValidator, engine *validator.Validate) {
+		engine.RegisterValidationCtx(rule, func(ctx context.Context, fl validator.FieldLevel) bool {
+			return f(ctx, iv.fieldLevel(fl))
+		})
+	})
+}
+
+func (v *I18nValidator) AddValidationWithError(rule string, f func(fl validator.FieldLevel) (bool, error)) {
+	v.addValidationWithError(rule, func(_ *I18nValidator, fl validator.FieldLevel) (bool, error) {
+		return f(fl)
+	})
+}
+
+// addValidationWithError registers a validation function that may fail with an error and receives
+// the validator it is bound to, so Clone rebinds it to the copy.
+func (v *I18nValidator) addValidationWithError(rule string, f func(iv *I18nValidator, fl validator.FieldLevel) (bool, error)) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return
+	}
+
+	v.register(func(iv *I18nValidator, engine *validator.Validate) {
+		engine.RegisterValidationCtx(rule, func(ctx context.Context, fl validator.FieldLevel) bool {
+			ok, err := f(iv, iv.fieldLevel(fl))
+			if err != nil {
+				collect(ctx, err)
+				return false
+			}
+			return ok
+		})
+	})
+}
+
+// addExtractor registers a parameter extractor receiving the validator it is bound to, so Clone rebinds it to the copy.
+func (v *I18nValidator) addExtractor(rule string, fn func(iv *I18nValidator, param string, value any) map[string]any) {
+	v.register(func(iv *I18nValidator, _ *validator.Validate) {
+		iv.extractors[rule] = func(param string, value any) map[string]any {
+			return fn(iv, param, value)
+		}
+	})
+}
+
+// register applies the registration to the validator and its underlying engine and records it to be replayed by Clone.
+// Registrations must use the passed validator and engine instead of captured ones to bind to the copy on replay.
+// The lock keeps registrations consistent with a concurrent Clone, so fn must not call locking methods of the validator.
+func (v *I18nValidator) register(fn func(iv *I18nValidator, engine *validator.Validate)) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	fn(v, v.validator)
+	v.registrations = append(v.registrations, fn)
+}
+
+func (v *I18nValidator) Clone(newEngine func() *validator.Validate) Validator {
+	var engine *validator.Validate
+	if newEngine != nil {
+		engine = newEngine()
+	}
+	if engine == nil {
+		engine = validator.New()
+	}
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	clone := &I18nValidator{
+		settings:      v.settings,
+		validator:     engine,
+		registrations: slices.Clone(v.registrations),
+		extractors:    maps.Clone(v.extractors),
+		fieldRefs:     maps.Clone(v.fieldRefs),
+		locales:       maps.Clone(v.locales),
+	}
+
+	// Replay registrations on the new engine
+	for _, fn := range clone.registrations {
+		fn(clone, clone.validator)
+	}
+	return clone
+}
+
+// fieldName resolves the reported name of the struct field through the registered tag name function.
+// It defaults to the Go field name if the field is not found or has no resolved name.
+func (v *I18nValidator) fieldName(t reflect.Type, name string) string {
+	if v.tagNameFunc == nil {
+		return name
+	}
+
+	t = derefType(t)
+	if t.Kind() != reflect.Struct {
+		return name
+	}
+
+	if field, ok := t.FieldByName(name); ok {
+		if resolved := v.tagNameFunc(field); resolved != "" {
+			return resolved
+		}
+	}
+	return name
+}
+
+// fieldLevel wraps the field level to trim string fields if trim space is enabled.
+func (v *I18nValidator) fieldLevel(fl validator.FieldLevel) validator.FieldLevel {
+	if v.trimSpace {
+		return trimmedFieldLevel{fl}
+	}
+	return fl
 }
 
 func (v *I18nValidator) AddTranslation(locale, rule, message string, options ...goi18n.PluralOption) {
@@ -28,6 +195,12 @@ func (v *I18nValidator) AddTranslation(locale, rule, message string, options ...
 		return
 	}
 
+	if locale = strings.TrimSpace(locale); locale != "" {
+		v.mutex.Lock()
+		v.locales[locale] = struct{}{}
+		v.mutex.Unlock()
+	}
+
 	if v.prefix == "" {
 		v.translator.AddMessage(locale, rule, message, options...)
 	} else {
@@ -35,57 +208,299 @@ func (v *I18nValidator) AddTranslation(locale, rule, message string, options ...
 	}
 }
 
+func (v *I18nValidator) AddTranslations(rule string, byLocale map[string]string, options ...goi18n.PluralOption) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" || v.translator == nil {
+		return
+	}
+
+	// Resolve translation key once
+	if v.prefix != "" {
+		rule = v.prefix + "." + rule
+	}
+
+	for locale, message := range byLocale {
+		locale, message = strings.TrimSpace(locale), strings.TrimSpace(message)
+		if locale == "" || message == "" {
+			continue
+		}
+
+		v.mutex.Lock()
+		v.locales[locale] = struct{}{}
+		v.mutex.Unlock()
+
+		v.translator.AddMessage(locale, rule, message, options...)
+	}
+}
+
+func (v *I18nValidator) HasLocale(locale string) bool {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	_, exists := v.locales[strings.TrimSpace(locale)]
+	return exists
+}
+
+// supportedLocales returns the sorted list of locales registered through AddTranslation.
+func (v *I18nValidator) supportedLocales() []string {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	return slices.Sorted(maps.Keys(v.locales))
+}
+
 func (v *I18nValidator) Struct(locale string, value any) ValidationError {
+	return v.StructCtx(context.Background(), locale, value)
+}
+
+func (v *I18nValidator) StructCtx(ctx context.Context, locale string, value any) ValidationError {
+	return v.observe(value, func() ValidationError {
+		return v.validateStruct(ctx, locale, value)
+	})
+}
+
+// validateStruct validates the struct without reporting to observer.
+func (v *I18nValidator) validateStruct(ctx context.Context, locale string, value any) ValidationError {
+	if err := checkStruct(value); err != nil {
+		return NewError(err)
+	}
+
+	ctx, collector := newCollectorContext(ctx)
 	return v.parseStructErrors(
 		locale,
 		value,
-		v.validator.Struct(value),
+		collector, v.validator.StructCtx(ctx, value),
 	)
 }
 
 func (v *I18nValidator) StructExpect(locale string, value any, fields ...string) ValidationError {
-	return v.parseStructErrors(
-		locale,
-		value,
-		v.validator.StructExcept(value, fields...),
-	)
+	return v.observe(value, func() ValidationError {
+		if err := checkStruct(value); err != nil {
+			return NewError(err)
+		}
+
+		ctx, collector := newCollectorContext(context.Background())
+		return v.parseStructErrors(
+			locale,
+			value,
+			collector, v.validator.StructExceptCtx(ctx, value, fields...),
+		)
+	})
 }
 
 func (v *I18nValidator) StructPartial(locale string, value any, fields ...string) ValidationError {
-	return v.parseStructErrors(
-		locale,
-		value,
-		v.validator.StructPartial(value, fields...),
-	)
+	return v.observe(value, func() ValidationError {
+		if err := checkStruct(value); err != nil {
+			return NewError(err)
+		}
+
+		ctx, collector := newCollectorContext(context.Background())
+		return v.parseStructErrors(
+			locale,
+			value,
+			collector, v.validator.StructPartialCtx(ctx, value, fields...),
+		)
+	})
+}
+
+func (v *I18nValidator) StructFiltered(locale string, value any, fn validator.FilterFunc) ValidationError {
+	return v.observe(value, func() ValidationError {
+		if err := checkStruct(value); err != nil {
+			return NewError(err)
+		}
+
+		ctx, collector := newCollectorContext(context.Background())
+		return v.parseStructErrors(
+			locale,
+			value,
+			collector, v.validator.StructFilteredCtx(ctx, value, fn),
+		)
+	})
+}
+
+func (v *I18nValidator) Map(locale string, m any, keyRules ...string) ValidationError {
+	return v.observe(m, func() ValidationError {
+		return v.validateMap(locale, m, resolveParams("", keyRules...))
+	})
+}
+
+// validateMap validates each key and struct value of the map without reporting to observer.
+func (v *I18nValidator) validateMap(locale string, m any, keyRules string) ValidationError {
+	// Ensure the value is a map
+	rv := reflect.ValueOf(m)
+	if rv.Kind() != reflect.Map {
+		return NewError(fmt.Errorf("govalidator: Map expects a map value, got %T", m))
+	}
+
+	// Validate each map value and key errors by map key
+	res := newErrors()
+	iter := rv.MapRange()
+	for iter.Next() {
+		key := fmt.Sprint(iter.Key().Interface())
+
+		// Validate the map key against key rules
+		if keyRules != "" {
+			ctx, collector := newCollectorContext(context.Background())
+			errs := v.parseVariableErrors(
+				locale, "key", iter.Key().Interface(),
+				collector, v.validator.VarCtx(ctx, iter.Key().Interface(), keyRules),
+			).(*vErrors)
+			if errs.HasInternalError() {
+				return errs
+			}
+			for _, rules := range errs.valerr {
+				for rule, messages := range rules {
+					for _, message := range messages {
+						res.AddError("["+key+"]:key", rule, message)
+					}
+				}
+			}
+		}
+
+		value := iter.Value()
+		if value.Kind() == reflect.Interface {
+			value = value.Elem()
+		}
+
+		// Ensure the map value is a struct or pointer to struct
+		if !value.IsValid() {
+			return NewError(fmt.Errorf("govalidator: Map expects struct values, got nil for key %q", key))
+		}
+		t := value.Type()
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return NewError(fmt.Errorf("govalidator: Map expects struct values, got %s for key %q", value.Type(), key))
+		}
+
+		errs := v.validateStruct(context.Background(), locale, value.Interface())
+		if errs.HasInternalError() {
+			return errs
+		}
+		res.merge("["+key+"].", errs.(*vErrors))
+	}
+
+	return res
 }
 
 func (v *I18nValidator) Var(locale, name string, value any, rules string) ValidationError {
-	return v.parseVariableErrors(
-		locale,
-		name,
-		value,
-		v.validator.Var(value, rules),
-	)
+	return v.VarCtx(context.Background(), locale, name, value, rules)
+}
+
+func (v *I18nValidator) VarCtx(ctx context.Context, locale, name string, value any, rules string) ValidationError {
+	return v.observe(nil, func() ValidationError {
+		ctx, collector := newCollectorContext(ctx)
+		return v.parseVariableErrors(
+			locale,
+			name,
+			value,
+			collector, v.validator.VarCtx(ctx, value, rules),
+		)
+	})
 }
 
 func (v *I18nValidator) VarWithValue(locale, name string, value any, other any, rules string) ValidationError {
-	return v.parseVariableErrors(
-		locale,
-		name,
-		value,
-		v.validator.VarWithValue(value, other, rules),
-	)
+	return v.observe(nil, func() ValidationError {
+		ctx, collector := newCollectorContext(context.Background())
+		return v.parseVariableErrors(
+			locale,
+			name,
+			value,
+			collector, v.validator.VarWithValueCtx(ctx, value, other, rules),
+		)
+	})
+}
+
+func (v *I18nValidator) Vars(locale string, items []VarItem) ValidationError {
+	return v.observe(nil, func() ValidationError {
+		res := newErrors()
+		for _, item := range items {
+			ctx, collector := newCollectorContext(context.Background())
+			errs := v.parseVariableErrors(
+				locale,
+				item.Name,
+				item.Value,
+				collector, v.validator.VarCtx(ctx, item.Value, item.Rules),
+			).(*vErrors)
+			if errs.HasInternalError() {
+				return errs
+			}
+
+			// Key errors by item name
+			for field, rules := range errs.valerr {
+				for rule, messages := range rules {
+					for _, message := range messages {
+						res.AddError(item.Name, rule, message)
+					}
+					if detail, ok := errs.details[field][rule]; ok {
+						res.addDetail(item.Name, rule, detail)
+					}
+				}
+			}
+		}
+		return res
+	})
+}
+
+// observe runs the validation and reports its duration and error count to the observer, if set.
+// Struct and map validations are reported by the value type name (e.g. main.User), variables as "var".
+// Internal error translator, if set, is attached to the result.
+func (v *I18nValidator) observe(value any, validate func() ValidationError) ValidationError {
+	if v.internalTranslator != nil {
+		run := validate
+		validate = func() ValidationError {
+			res := run()
+			if e, ok := res.(*vErrors); ok && e.interr != nil {
+				e.translator = v.internalTranslator
+			}
+			return res
+		}
+	}
+
+	if v.observer == nil {
+		return validate()
+	}
+
+	start := time.Now()
+	res := validate()
+	elapsed := time.Since(start)
+
+	kind := "var"
+	if t := derefType(reflect.TypeOf(value)); t != nil {
+		kind = t.String()
+	}
+	v.observer(kind, elapsed, res.Count())
+	return res
 }
 
 // translate generates a localized error message based on the provided value, field, and parameters.
-func (v *I18nValidator) translate(locale, name, rule, field string, param, value any, count int) string {
+// Message can reference {field}, {param} and the offending {value} placeholders. Multi-part parameters,
+// like the conditional required_if=Type premium family, are also available as {param0}, {param1}, ...
+// Referenced fields of cross-field rules ({other}) are resolved against the struct declaring the field
+// addressed by struct namespace, variables have empty namespace. Parameters captured by validation functions
+// during the call (extra) are merged last.
+func (v *I18nValidator) translate(locale, name, rule, field, namespace, param string, value, input any, extra map[string]any) string {
 	// Return empty string if translator not passed to I18nValidator
 	if v.translator == nil {
 		return ""
 	}
 
+	// Skip translation interfaces lookup if disabled
+	translatable := value
+	if v.skipInterfaces {
+		translatable = nil
+	}
+
+	// Try resolving error translation using the ValueTranslatable interface
+	if t, ok := translatable.(ValueTranslatable); ok {
+		if res := t.TranslateErrorValue(locale, rule, field, input, param); res != "" {
+			return res
+		}
+	}
+
 	// Try resolving error translation using the Translatable interface
-	if t, ok := value.(Translatable); ok {
+	if t, ok := translatable.(Translatable); ok {
 		if res := t.TranslateError(locale, rule, field); res != "" {
 			return res
 		}
@@ -93,28 +508,88 @@ func (v *I18nValidator) translate(locale, name, rule, field string, param, value
 
 	// Use the main translator to generate the message with pluralization support
 	// If a prefix is set, prepend it to the rule
+	tag := rule
 	if v.prefix != "" {
 		rule = v.prefix + "." + rule
 	}
 
 	// Next, attempt to translate the field name using TranslatableField interface
-	if t, ok := value.(TranslatableField); ok {
+	if t, ok := translatable.(TranslatableField); ok {
 		if n := t.TranslateTitle(locale, field); n != "" {
 			name = n
 		}
 	}
 
-	return v.translator.Plural(locale, rule, count, map[string]any{
+	// Parse the rule parameter and handle its type (int or float)
+	data := map[string]any{
 		"field": name,
 		"param": param,
-	})
+		"value": input,
+	}
+	var count int
+	i, f := parseNumeric(param)
+	if i != nil {
+		data["param"] = *i
+		count = int(*i)
+	} else if f != nil {
+		data["param"] = *f
+		count = int(*f)
+	}
+
+	// Format the numeric parameter for the locale, the raw count still selects the plural form
+	if v.numberFormatter != nil && (i != nil || f != nil) {
+		data["param"] = v.numberFormatter(locale, data["param"])
+	}
+
+	// Resolve the struct declaring the field only for rules referencing its siblings
+	_, isCrossField := crossFieldRules[tag]
+	ref, isFieldRef := v.fieldRefs[tag]
+	var parent reflect.Type
+	if isCrossField || isFieldRef {
+		parent, _, _ = parentType(value, namespace)
+	}
+
+	// Expose the referenced field of cross-field rules as {other}
+	if isCrossField && hasFieldPath(parent, param) {
+		data["other"] = param
+	}
+
+	// Expose the translated display name of referenced field for field reference rules as {other}
+	// Title of nested field is resolved by the declaring struct type instead of the validated value
+	if isFieldRef {
+		if other := ref(param); hasFieldPath(parent, other) {
+			data["other"] = other
+			titler := translatable
+			if !v.skipInterfaces && parent != derefType(reflect.TypeOf(value)) {
+				titler = reflect.New(parent).Interface()
+			}
+			if t, ok := titler.(TranslatableField); ok {
+				if n := t.TranslateTitle(locale, other); n != "" {
+					data["other"] = n
+				}
+			}
+		}
+	}
+
+	// Expose each part of multi-part parameter as {param0}, {param1}, ...
+	for i, part := range splitParams(param) {
+		data["param"+strconv.Itoa(i)] = part
+	}
+
+	// Merge additional parameters extracted by rule extractor or captured during validation, they take precedence
+	if extractor, ok := v.extractors[tag]; ok {
+		maps.Copy(data, extractor(param, input))
+	}
+	maps.Copy(data, extra)
+
+	return v.translator.Plural(locale, rule, count, data)
 }
 
 // parseStructErrors processes and translates validation errors
 // based on the provided locale and value for struct.
-func (v *I18nValidator) parseStructErrors(locale string, value any, err error) ValidationError {
-	// Skip nil error
-	if err == nil {
+func (v *I18nValidator) parseStructErrors(locale string, value any, collector *errorCollector, err error) ValidationError {
+	// Skip nil error, internal error collected during validation takes precedence
+	if err = collector.resolve(err); err == nil {
 		return NewEmptyError()
 	}
 
@@ -125,45 +600,61 @@ func (v *I18nValidator) parseStructErrors(locale string, value any, err error) V
 	}
 
 	// Initialize the result validation error
-	res := NewEmptyError()
+	res := newErrors()
 
 	// Iterate over each validation error and process
 	for _, field := range errs {
-		// Parse the field parameter and handle its type (int or float)
-		var count int
-		var param any = field.Param()
-		if i, f := parseNumeric(field.Param()); i != nil {
-			param = *i
-			count = int(*i)
-		} else if f != nil {
-			param = *f
-			count = int(*f)
+		// Resolve error key based on key mode
+		key := field.Field()
+		if v.keyMode == KeyNamespace {
+			key = trimNamespace(field.Namespace())
+		} else if depth, ok := shadowedDepth(value, field.StructNamespace()); ok {
+			// Keep shadowed embedded fields distinct (e.g. Audit.CreatedBy)
+			segments := strings.Split(field.Namespace(), ".")
+			key = strings.Join(segments[len(segments)-depth:], ".")
 		}
 
-		// Add the translated error if translator available or raw error to the result
-		if v.translator == nil {
-			res.AddError(field.Field(), field.Tag(), field.Error())
+		// Stop at the first failing field in bail mode
+		if v.bail && res.HasValidationErrors() && !res.IsFailed(key) {
+			break
+		}
+
+		// Add the inline message, translated error if translator available or raw error to the result
+		if message, ok := v.inlineMessage(value, field); ok {
+			res.AddError(key, field.Tag(), message)
+		} else if v.translator == nil {
+			res.AddError(key, field.Tag(), field.Error())
 		} else {
 			res.AddError(
-				field.Field(),
+				key,
 				field.Tag(),
 				v.translate(
-					locale, field.Field(), field.Tag(),
-					field.StructField(), param, value, count,
+					locale, field.Field(), field.Tag(), field.StructField(),
+					field.StructNamespace(), field.Param(), value, field.Value(),
+					collector.extra(field.Tag(), field.Param(), field.Value()),
 				),
 			)
 		}
-
+		res.addDetail(key, field.Tag(), toFieldDetail(field))
+		res.fields[key] = field.StructField()
 	}
 
 	// Return the aggregated validation errors
 	return res
 }
 
+// inlineMessage resolves the message of failed rule from the field message tag, if enabled.
+func (v *I18nValidator) inlineMessage(value any, field validator.FieldError) (string, bool) {
+	if v.messageTag == "" {
+		return "", false
+	}
+	return inlineMessage(value, field.StructNamespace(), v.messageTag, field.Tag())
+}
+
 // parseVariableErrors processes and translates validation errors based on the provided locale and value for variable.
-func (v *I18nValidator) parseVariableErrors(locale, name string, value any, err error) ValidationError {
-	// Skip nil error
-	if err == nil {
+func (v *I18nValidator) parseVariableErrors(locale, name string, value any, collector *errorCollector, err error) ValidationError {
+	// Skip nil error, internal error collected during validation takes precedence
+	if err = collector.resolve(err); err == nil {
 		return NewEmptyError()
 	}
 
@@ -174,33 +665,25 @@ func (v *I18nValidator) parseVariableErrors(locale, name string, value any, err
 	}
 
 	// Initialize the result validation error
-	res := NewEmptyError()
+	res := newErrors()
 
 	// Iterate over each validation error and process
 	for _, field := range errs {
-		// Parse the field parameter and handle its type (int or float)
-		var count int
-		var param any = field.Param()
-		if i, f := parseNumeric(field.Param()); i != nil {
-			param = *i
-			count = int(*i)
-		} else if f != nil {
-			param = *f
-			count = int(*f)
-		}
-
 		// Add the translated error if translator available or raw error to the result
 		if v.translator == nil {
 			res.AddError(field.Field(), field.Tag(), field.Error())
+			res.addDetail(field.Field(), field.Tag(), toFieldDetail(field))
 		} else {
 			res.AddError(
 				name,
 				field.Tag(),
 				v.translate(
 					locale, name, field.Tag(), name,
-					param, value, count,
+					"", field.Param(), value, field.Value(),
+					collector.extra(field.Tag(), field.Param(), field.Value()),
 				),
 			)
+			res.addDetail(name, field.Tag(), toFieldDetail(field))
 		}
 	}
 
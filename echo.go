@@ -0,0 +1,27 @@
+package govalidator
+
+// EchoValidator adapts a Validator to the echo.Validator interface.
+// Register it on the echo instance:
+//
+//	e := echo.New()
+//	e.Validator = govalidator.NewEchoValidator(v, "en")
+type EchoValidator struct {
+	validator Validator
+	locale    string
+}
+
+// NewEchoValidator creates a new EchoValidator validating structs with the given locale.
+func NewEchoValidator(v Validator, locale string) *EchoValidator {
+	return &EchoValidator{
+		validator: v,
+		locale:    locale,
+	}
+}
+
+// Validate validates the struct and returns the ValidationError as error if validation fails, or nil otherwise.
+func (e *EchoValidator) Validate(i any) error {
+	if err := e.validator.Struct(e.locale, i); err.HasError() {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package govalidator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mekramy/govalidator"
+)
+
+func TestEchoValidator(t *testing.T) {
+	// echoValidator mirrors the echo.Validator interface
+	type echoValidator interface {
+		Validate(i any) error
+	}
+
+	type User struct {
+		Name string `validate:"required"`
+	}
+
+	var ev echoValidator = govalidator.NewEchoValidator(newTestValidator(), "en")
+
+	t.Run("Valid", func(t *testing.T) {
+		if err := ev.Validate(User{Name: "John"}); err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		err := ev.Validate(User{})
+		var verr govalidator.ValidationError
+		if err == nil {
+			t.Fatal("expected validation error")
+		} else if !errors.As(err, &verr) {
+			t.Fatal("expected ValidationError")
+		} else if !verr.IsFailedOn("Name", "required") {
+			t.Fatal("expected Name to fail on required")
+		}
+	})
+}
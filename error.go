@@ -2,6 +2,9 @@ package govalidator
 
 import (
 	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
 	"strings"
 )
 
@@ -22,48 +25,124 @@ type ValidationError interface {
 	// IsFailedOn checks if a specific field failed on a given validation rule.
 	IsFailedOn(field, rule string) bool
 
+	// IsMissing checks if a specific field failed only on required rules (required or required_* like required_if),
+	// distinguishing empty fields from present but invalid ones.
+	IsMissing(field string) bool
+
 	// InternalError returns the internal system error related to the validation process, if any.
 	InternalError() error
 
+	// InternalMessage returns the user-safe localized message of the internal error, if any.
+	// Message is resolved by the translator registered through WithInternalErrorTranslator,
+	// falling back to the internal error message if translator is not set or returns empty string.
+	InternalMessage(locale string) string
+
+	// Count returns the total number of field and rule failures.
+	Count() int
+
 	// Errors returns a nested map of validation errors for each field and rule.
+	// Multiple messages of the same field and rule are joined with "; ".
 	Errors() map[string]map[string]string
 
+	// Details returns a nested map of raw failure details (parameter, value and kind) for each field and rule.
+	// Details are available for errors produced by validation, not for errors added through AddError.
+	Details() map[string]map[string]FieldDetail
+
+	// FieldNames returns a map of error keys to the original struct field names (e.g. {"email": "Email"}).
+	// Names are available for errors produced by struct validation, not for variables or errors added through AddError.
+	FieldNames() map[string]string
+
 	// Messages returns a map of validation error messages for each field.
 	Messages() map[string][]string
 
 	// Rules returns a map of validation error rules for each field.
 	Rules() map[string][]string
 
+	// Only returns a new ValidationError containing only the given fields, preserving the internal error.
+	Only(fields ...string) ValidationError
+
+	// Except returns a new ValidationError excluding the given fields, preserving the internal error.
+	Except(fields ...string) ValidationError
+
 	// MarshalJSON serializes the validation errors into JSON format.
+	// The internal error is never included, use MarshalJSONWithInternal to opt in.
 	MarshalJSON() ([]byte, error)
 
+	// MarshalJSONWithInternal serializes the validation errors into JSON format including the internal error,
+	// if any, under the InternalErrorKey key. The internal error is written as InternalMessage of the locale,
+	// so register WithInternalErrorTranslator to avoid exposing raw error text to clients.
+	MarshalJSONWithInternal(locale string) ([]byte, error)
+
+	// MarshalErrorsList serializes the validation errors into a JSON array of field, rule and message objects.
+	// Items are sorted by field and rule for stable output.
+	MarshalErrorsList() ([]byte, error)
+
 	// String returns a string representation of all validation errors.
 	String() string
 
+	// Error implements the error interface.
+	// It returns the internal error message if present, otherwise the string representation of validation errors.
+	Error() string
+
 	// AddError records a validation error for a specific field and validation rule.
+	// Messages added for the same field and rule are appended, not overwritten.
 	AddError(field, rule string, message ...string)
 }
 
+// InternalErrorKey is the reserved JSON key holding the internal error message in MarshalJSONWithInternal output.
+// Validated fields are exported, so their names can't start with underscore. Tag resolved names must not use this key.
+const InternalErrorKey = "_internal"
+
+// Struct input errors returned as internal error by Struct, StructExpect and StructPartial.
+// Use errors.Is on InternalError to distinguish programmer errors from validation failures.
+var (
+	ErrNilValue   = errors.New("govalidator: struct value is nil")
+	ErrNotAStruct = errors.New("govalidator: value is not a struct")
+)
+
+// ErrorItem represents a single field and rule failure in list form.
+type ErrorItem struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// FieldDetail contains the raw details of a failed validation rule.
+type FieldDetail struct {
+	Param string // Param is the rule parameter (e.g. "3" for min=3).
+	Value any    // Value is the actual field value.
+	Kind  string // Kind is the reflect kind of the field (e.g. "string").
+}
+
 // NewError creates a new ValidationError with an internal error.
 func NewError(err error) ValidationError {
-	return &vErrors{
-		interr: err,
-		valerr: make(map[string]map[string]string),
-	}
+	res := newErrors()
+	res.interr = err
+	return res
 }
 
 // NewEmptyError creates a new empty ValidationError without any internal error.
 func NewEmptyError() ValidationError {
+	return newErrors()
+}
+
+// newErrors creates a new empty vErrors.
+func newErrors() *vErrors {
 	return &vErrors{
-		interr: nil,
-		valerr: make(map[string]map[string]string),
+		interr:  nil,
+		valerr:  make(map[string]map[string][]string),
+		details: make(map[string]map[string]FieldDetail),
+		fields:  make(map[string]string),
 	}
 }
 
 // vError handles validation errors and implements the ValidationError interface.
 type vErrors struct {
-	interr error
-	valerr map[string]map[string]string
+	interr     error
+	valerr     map[string]map[string][]string
+	details    map[string]map[string]FieldDetail
+	fields     map[string]string
+	translator func(locale string, err error) string
 }
 
 func (e *vErrors) HasError() bool {
@@ -88,20 +167,70 @@ func (e *vErrors) IsFailedOn(field, rule string) bool {
 	return exists
 }
 
+func (e *vErrors) IsMissing(field string) bool {
+	errs, exists := e.valerr[field]
+	if !exists {
+		return false
+	}
+
+	for rule := range errs {
+		if rule != "required" && !strings.HasPrefix(rule, "required_") {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *vErrors) InternalError() error {
 	return e.interr
 }
 
+func (e *vErrors) InternalMessage(locale string) string {
+	if e.interr == nil {
+		return ""
+	}
+
+	if e.translator != nil {
+		if msg := e.translator(locale, e.interr); msg != "" {
+			return msg
+		}
+	}
+	return e.interr.Error()
+}
+
+func (e *vErrors) Count() int {
+	count := 0
+	for _, errs := range e.valerr {
+		count += len(errs)
+	}
+	return count
+}
+
 func (e *vErrors) Errors() map[string]map[string]string {
-	return e.valerr
+	errors := make(map[string]map[string]string, len(e.valerr))
+	for field, errs := range e.valerr {
+		errors[field] = make(map[string]string, len(errs))
+		for rule, messages := range errs {
+			errors[field][rule] = strings.Join(messages, "; ")
+		}
+	}
+	return errors
+}
+
+func (e *vErrors) Details() map[string]map[string]FieldDetail {
+	return e.details
+}
+
+func (e *vErrors) FieldNames() map[string]string {
+	return e.fields
 }
 
 func (e *vErrors) Messages() map[string][]string {
 	messages := make(map[string][]string)
 	for field, errs := range e.valerr {
 		messages[field] = make([]string, 0)
-		for _, message := range errs {
-			messages[field] = append(messages[field], message)
+		for _, msgs := range errs {
+			messages[field] = append(messages[field], msgs...)
 		}
 	}
 	return messages
@@ -118,28 +247,140 @@ func (e *vErrors) Rules() map[string][]string {
 	return rules
 }
 
+func (e *vErrors) Only(fields ...string) ValidationError {
+	return e.filter(func(field string) bool {
+		return slices.Contains(fields, field)
+	})
+}
+
+func (e *vErrors) Except(fields ...string) ValidationError {
+	return e.filter(func(field string) bool {
+		return !slices.Contains(fields, field)
+	})
+}
+
 func (e *vErrors) MarshalJSON() ([]byte, error) {
-	return json.Marshal(e.valerr)
+	return json.Marshal(e.Errors())
+}
+
+func (e *vErrors) MarshalJSONWithInternal(locale string) ([]byte, error) {
+	if e.interr == nil {
+		return e.MarshalJSON()
+	}
+
+	res := make(map[string]any, len(e.valerr)+1)
+	for field, errs := range e.Errors() {
+		res[field] = errs
+	}
+	res[InternalErrorKey] = e.InternalMessage(locale)
+	return json.Marshal(res)
+}
+
+func (e *vErrors) MarshalErrorsList() ([]byte, error) {
+	items := make([]ErrorItem, 0, e.Count())
+	for _, field := range slices.Sorted(maps.Keys(e.valerr)) {
+		for _, rule := range slices.Sorted(maps.Keys(e.valerr[field])) {
+			for _, message := range e.valerr[field][rule] {
+				items = append(items, ErrorItem{
+					Field:   field,
+					Rule:    rule,
+					Message: message,
+				})
+			}
+		}
+	}
+	return json.Marshal(items)
 }
 
 func (e *vErrors) String() string {
 	var builder strings.Builder
 	for field, errs := range e.valerr {
 		builder.WriteString(field + ":\n")
-		for rule, message := range errs {
-			builder.WriteString("    " + rule + ": " + message + "\n")
+		for rule, messages := range errs {
+			for _, message := range messages {
+				builder.WriteString("    " + rule + ": " + message + "\n")
+			}
 		}
 	}
 	return builder.String()
 }
 
+func (e *vErrors) Error() string {
+	if e.interr != nil {
+		return e.interr.Error()
+	}
+	return strings.TrimSpace(e.String())
+}
+
 func (e *vErrors) AddError(field, rule string, message ...string) {
 	msg := resolveParams("", message...)
-	_, exists := e.valerr[field]
-	if exists {
-		e.valerr[field][rule] = msg
-	} else {
-		e.valerr[field] = map[string]string{rule: msg}
+	if _, exists := e.valerr[field]; !exists {
+		e.valerr[field] = make(map[string][]string)
 	}
 
+	// Keep a single empty message only until a real message is added
+	messages := e.valerr[field][rule]
+	switch {
+	case len(messages) == 0:
+		e.valerr[field][rule] = []string{msg}
+	case msg == "":
+		return
+	case len(messages) == 1 && messages[0] == "":
+		e.valerr[field][rule] = []string{msg}
+	default:
+		e.valerr[field][rule] = append(messages, msg)
+	}
+}
+
+// addDetail records the raw failure details for a specific field and validation rule.
+func (e *vErrors) addDetail(field, rule string, detail FieldDetail) {
+	if _, exists := e.details[field]; !exists {
+		e.details[field] = make(map[string]FieldDetail)
+	}
+	e.details[field][rule] = detail
+}
+
+// merge adds the validation errors and details of other with field keys prefixed by prefix.
+func (e *vErrors) merge(prefix string, other *vErrors) {
+	for field, errs := range other.valerr {
+		for rule, messages := range errs {
+			for _, message := range messages {
+				e.AddError(prefix+field, rule, message)
+			}
+		}
+	}
+	for field, details := range other.details {
+		for rule, detail := range details {
+			e.addDetail(prefix+field, rule, detail)
+		}
+	}
+	for field, name := range other.fields {
+		e.fields[prefix+field] = name
+	}
+}
+
+// filter returns a copy of errors containing only the fields accepted by keep.
+func (e *vErrors) filter(keep func(field string) bool) ValidationError {
+	res := newErrors()
+	res.interr = e.interr
+	res.translator = e.translator
+	for field, errs := range e.valerr {
+		if keep(field) {
+			res.valerr[field] = make(map[string][]string, len(errs))
+			for rule, messages := range errs {
+				res.valerr[field][rule] = slices.Clone(messages)
+			}
+		}
+	}
+	for field, details := range e.details {
+		if keep(field) {
+			res.details[field] = maps.Clone(details)
+		}
+	}
+	for field, name := range e.fields {
+		if keep(field) {
+			res.fields[field] = name
+		}
+	}
+	return res
 }
@@ -0,0 +1,199 @@
+package govalidator_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mekramy/govalidator"
+)
+
+func TestCount(t *testing.T) {
+	err := govalidator.NewEmptyError()
+	if err.Count() != 0 {
+		t.Fatalf("expected 0 errors, got %d", err.Count())
+	}
+
+	err.AddError("name", "required", "name is required")
+	if err.Count() != 1 {
+		t.Fatalf("expected 1 error, got %d", err.Count())
+	}
+
+	err.AddError("email", "required", "email is required")
+	err.AddError("email", "email", "email is invalid")
+	if err.Count() != 3 {
+		t.Fatalf("expected 3 errors, got %d", err.Count())
+	}
+}
+
+func TestOnlyAndExcept(t *testing.T) {
+	err := govalidator.NewError(errors.New("internal"))
+	err.AddError("name", "required", "name is required")
+	err.AddError("email", "email", "email is invalid")
+	err.AddError("phone", "mobile", "phone is invalid")
+
+	t.Run("Only", func(t *testing.T) {
+		res := err.Only("name", "phone")
+		if !res.IsFailed("name") || !res.IsFailed("phone") || res.IsFailed("email") {
+			t.Fatal("expected only name and phone errors")
+		} else if res.InternalError() == nil {
+			t.Fatal("expected internal error to be preserved")
+		}
+	})
+
+	t.Run("Except", func(t *testing.T) {
+		res := err.Except("name")
+		if res.IsFailed("name") || !res.IsFailed("email") || !res.IsFailed("phone") {
+			t.Fatal("expected email and phone errors")
+		}
+
+		res.AddError("email", "required", "email is required")
+		if err.IsFailedOn("email", "required") {
+			t.Fatal("expected receiver not to be mutated")
+		}
+	})
+
+	if err.Count() != 3 {
+		t.Fatal("expected receiver not to be mutated")
+	}
+}
+
+func TestMarshalErrorsList(t *testing.T) {
+	err := govalidator.NewEmptyError()
+	err.AddError("name", "required", "name is required")
+	err.AddError("email", "required", "email is required")
+	err.AddError("email", "email", "email is invalid")
+
+	t.Run("Map", func(t *testing.T) {
+		res, e := err.MarshalJSON()
+		expected := `{"email":{"email":"email is invalid","required":"email is required"},"name":{"required":"name is required"}}`
+		if e != nil {
+			t.Fatal(e)
+		} else if string(res) != expected {
+			t.Fatalf("expected %s, got %s", expected, res)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		res, e := err.MarshalErrorsList()
+		expected := `[{"field":"email","rule":"email","message":"email is invalid"},` +
+			`{"field":"email","rule":"required","message":"email is required"},` +
+			`{"field":"name","rule":"required","message":"name is required"}]`
+		if e != nil {
+			t.Fatal(e)
+		} else if string(res) != expected {
+			t.Fatalf("expected %s, got %s", expected, res)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		res, e := govalidator.NewEmptyError().MarshalErrorsList()
+		if e != nil {
+			t.Fatal(e)
+		} else if string(res) != "[]" {
+			t.Fatalf("expected [], got %s", res)
+		}
+	})
+}
+
+func TestDetails(t *testing.T) {
+	type User struct {
+		Name string `validate:"min=3"`
+	}
+
+	err := newTestValidator().Struct("en", User{Name: "Jo"})
+	detail, ok := err.Details()["Name"]["min"]
+	if !ok {
+		t.Fatal("expected min detail on Name")
+	} else if detail.Param != "3" || detail.Value != "Jo" || detail.Kind != "string" {
+		t.Fatalf("unexpected detail %+v", detail)
+	}
+
+	if _, ok := err.Only("Email").Details()["Name"]; ok {
+		t.Fatal("expected filtered details")
+	}
+
+	verr := newTestValidator().Var("en", "age", 12, "min=18")
+	if detail := verr.Details()["age"]["min"]; detail.Param != "18" || detail.Value != 12 || detail.Kind != "int" {
+		t.Fatalf("unexpected detail %+v", detail)
+	}
+}
+
+func TestAddErrorAppend(t *testing.T) {
+	err := govalidator.NewEmptyError()
+	err.AddError("tags", "list", "item 0 is invalid")
+	err.AddError("tags", "list", "item 2 is invalid")
+
+	if msgs := err.Messages()["tags"]; len(msgs) != 2 || msgs[0] != "item 0 is invalid" || msgs[1] != "item 2 is invalid" {
+		t.Fatalf("expected both messages, got %v", msgs)
+	} else if msg := err.Errors()["tags"]["list"]; msg != "item 0 is invalid; item 2 is invalid" {
+		t.Fatalf("unexpected joined message %q", msg)
+	} else if str := err.String(); !strings.Contains(str, "item 0 is invalid") || !strings.Contains(str, "item 2 is invalid") {
+		t.Fatalf("expected both messages in string, got %q", str)
+	} else if err.Count() != 1 {
+		t.Fatalf("expected 1 failed rule, got %d", err.Count())
+	}
+
+	err.AddError("name", "required")
+	err.AddError("name", "required", "name is required")
+	if msgs := err.Messages()["name"]; len(msgs) != 1 || msgs[0] != "name is required" {
+		t.Fatalf("expected empty message to be replaced, got %v", msgs)
+	}
+}
+
+func TestMarshalJSONInternalError(t *testing.T) {
+	err := govalidator.NewError(errors.New("database down"))
+	err.AddError("name", "required", "name is required")
+	res, e := err.MarshalJSON()
+	expected := `{"name":{"required":"name is required"}}`
+	if e != nil {
+		t.Fatal(e)
+	} else if string(res) != expected {
+		t.Fatalf("expected internal error to be omitted, got %s", res)
+	}
+
+	res, _ = err.MarshalJSONWithInternal("en")
+	expected = `{"_internal":"database down","name":{"required":"name is required"}}`
+	if string(res) != expected {
+		t.Fatalf("expected %s, got %s", expected, res)
+	}
+
+	v := newTestValidator(govalidator.WithInternalErrorTranslator(func(locale string, err error) string {
+		return "invalid input"
+	}))
+	res, _ = v.Struct("en", 10).MarshalJSONWithInternal("en")
+	expected = `{"_internal":"invalid input"}`
+	if string(res) != expected {
+		t.Fatalf("expected %s, got %s", expected, res)
+	}
+}
+
+func TestIsMissing(t *testing.T) {
+	type User struct {
+		Email string `validate:"required,email"`
+		Phone string `validate:"required_without=Email"`
+		Name  string `validate:"omitempty,min=3"`
+	}
+
+	v := newTestValidator()
+	err := v.Struct("en", User{Name: "Jo"})
+	if !err.IsMissing("Email") || !err.IsMissing("Phone") {
+		t.Fatalf("expected Email and Phone to be missing, got %v", err.Rules())
+	} else if err.IsMissing("Name") {
+		t.Fatal("expected Name to be invalid, not missing")
+	}
+
+	err = v.Struct("en", User{Email: "invalid"})
+	if err.IsMissing("Email") || !err.IsFailedOn("Email", "email") {
+		t.Fatalf("expected Email to be invalid, not missing, got %v", err.Rules())
+	} else if err.IsMissing("Phone") {
+		t.Fatal("expected passing field not to be missing")
+	}
+
+	manual := govalidator.NewEmptyError()
+	manual.AddError("code", "required", "code is required")
+	manual.AddError("code", "numeric", "code must be numeric")
+	if manual.IsMissing("code") {
+		t.Fatal("expected field with format failure not to be missing")
+	}
+}
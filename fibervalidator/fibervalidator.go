@@ -0,0 +1,37 @@
+// Package fibervalidator provides fiber helpers for govalidator.
+package fibervalidator
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/mekramy/govalidator"
+)
+
+// FiberResponse writes the validation error as fiber JSON response.
+// Internal errors are written with 500 status as {"error": message}, where message is the InternalMessage
+// of the locale, or the generic internal server error text if no internal error translator resolves it,
+// so raw internal error text is never sent to clients.
+// Validation errors are written with 422 status using the error MarshalJSON body.
+// Nothing is written and nil is returned if err has no error.
+func FiberResponse(c fiber.Ctx, locale string, err govalidator.ValidationError) error {
+	if err == nil || !err.HasError() {
+		return nil
+	}
+
+	if err.HasInternalError() {
+		message := err.InternalMessage(locale)
+		if message == "" || message == err.InternalError().Error() {
+			message = fiber.ErrInternalServerError.Message
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": message,
+		})
+	}
+
+	body, e := err.MarshalJSON()
+	if e != nil {
+		return e
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Status(fiber.StatusUnprocessableEntity).Send(body)
+}
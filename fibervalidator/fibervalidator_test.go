@@ -0,0 +1,81 @@
+package fibervalidator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	"github.com/mekramy/govalidator"
+	"github.com/mekramy/govalidator/fibervalidator"
+	"github.com/valyala/fasthttp"
+)
+
+func TestFiberResponse(t *testing.T) {
+	app := fiber.New()
+	newCtx := func() fiber.Ctx {
+		return app.AcquireCtx(&fasthttp.RequestCtx{})
+	}
+
+	t.Run("NoError", func(t *testing.T) {
+		c := newCtx()
+		defer app.ReleaseCtx(c)
+
+		if err := fibervalidator.FiberResponse(c, "en", govalidator.NewEmptyError()); err != nil {
+			t.Fatal(err)
+		} else if len(c.Response().Body()) != 0 {
+			t.Fatal("expected empty body")
+		}
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		c := newCtx()
+		defer app.ReleaseCtx(c)
+
+		verr := govalidator.NewEmptyError()
+		verr.AddError("name", "required", "name is required")
+		expected := `{"name":{"required":"name is required"}}`
+
+		if err := fibervalidator.FiberResponse(c, "en", verr); err != nil {
+			t.Fatal(err)
+		} else if c.Response().StatusCode() != fiber.StatusUnprocessableEntity {
+			t.Fatalf("expected 422, got %d", c.Response().StatusCode())
+		} else if string(c.Response().Body()) != expected {
+			t.Fatalf("expected %s, got %s", expected, c.Response().Body())
+		} else if string(c.Response().Header.ContentType()) != fiber.MIMEApplicationJSON {
+			t.Fatalf("unexpected content type %s", c.Response().Header.ContentType())
+		}
+	})
+
+	t.Run("InternalError", func(t *testing.T) {
+		c := newCtx()
+		defer app.ReleaseCtx(c)
+
+		expected := `{"error":"Internal Server Error"}`
+		if err := fibervalidator.FiberResponse(c, "en", govalidator.NewError(errors.New("database down"))); err != nil {
+			t.Fatal(err)
+		} else if c.Response().StatusCode() != fiber.StatusInternalServerError {
+			t.Fatalf("expected 500, got %d", c.Response().StatusCode())
+		} else if string(c.Response().Body()) != expected {
+			t.Fatalf("expected %s, got %s", expected, c.Response().Body())
+		}
+	})
+
+	t.Run("TranslatedInternalError", func(t *testing.T) {
+		c := newCtx()
+		defer app.ReleaseCtx(c)
+
+		v := govalidator.NewValidator(
+			validator.New(),
+			govalidator.WithInternalErrorTranslator(func(locale string, err error) string {
+				return "try again later"
+			}),
+		)
+		expected := `{"error":"try again later"}`
+		if err := fibervalidator.FiberResponse(c, "en", v.Struct("en", 10)); err != nil {
+			t.Fatal(err)
+		} else if string(c.Response().Body()) != expected {
+			t.Fatalf("expected %s, got %s", expected, c.Response().Body())
+		}
+	})
+}
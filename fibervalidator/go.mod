@@ -0,0 +1,39 @@
+module github.com/mekramy/govalidator/fibervalidator
+
+go 1.23.5
+
+require (
+	github.com/go-playground/validator/v10 v10.25.0
+	github.com/gofiber/fiber/v3 v3.0.0-beta.3
+	github.com/mekramy/govalidator v0.0.0-20261016135602-30ff45d795a0
+	github.com/valyala/fasthttp v1.55.0
+)
+
+require (
+	github.com/andybalholm/brotli v1.1.0 // indirect
+	github.com/gabriel-vasile/mimetype v1.4.8 // indirect
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/gofiber/utils/v2 v2.0.0-beta.4 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/inhies/go-bytesize v0.0.0-20220417184213-4913239db9cf // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
+	github.com/leodido/go-urn v1.4.0 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/mekramy/goi18n v0.0.1 // indirect
+	github.com/mekramy/gojalaali v0.0.1 // indirect
+	github.com/tidwall/gjson v1.18.0 // indirect
+	github.com/tidwall/match v1.1.1 // indirect
+	github.com/tidwall/pretty v1.2.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	github.com/valyala/tcplisten v1.0.0 // indirect
+	golang.org/x/crypto v0.32.0 // indirect
+	golang.org/x/net v0.34.0 // indirect
+	golang.org/x/sys v0.29.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
+
+// Local development against the core module in the parent directory.
+// Replace directives are ignored by dependents, which resolve the required version above.
+replace github.com/mekramy/govalidator => ../
@@ -1,12 +1,28 @@
 package funcs
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math"
 	"math/big"
+	"mime"
 	"mime/multipart"
 	"net"
+	"net/url"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/inhies/go-bytesize"
@@ -54,10 +70,77 @@ func IsAlphaNumericWithPersian(value string, includes ...string) bool {
 	return re.MatchString(value)
 }
 
-// IsValidIranianPhone checks if the iranian phone number is valid.
+// IranianPhoneAreaCodes maps iranian landline area codes to their province.
+// Callers can extend or modify it before validation.
+var IranianPhoneAreaCodes = map[string]string{
+	"011": "Mazandaran",
+	"013": "Gilan",
+	"017": "Golestan",
+	"021": "Tehran",
+	"023": "Semnan",
+	"024": "Zanjan",
+	"025": "Qom",
+	"026": "Alborz",
+	"028": "Qazvin",
+	"031": "Isfahan",
+	"034": "Kerman",
+	"035": "Yazd",
+	"038": "Chaharmahal and Bakhtiari",
+	"041": "East Azerbaijan",
+	"044": "West Azerbaijan",
+	"045": "Ardabil",
+	"051": "Razavi Khorasan",
+	"054": "Sistan and Baluchestan",
+	"056": "South Khorasan",
+	"058": "North Khorasan",
+	"061": "Khuzestan",
+	"066": "Lorestan",
+	"071": "Fars",
+	"074": "Kohgiluyeh and Boyer-Ahmad",
+	"076": "Hormozgan",
+	"077": "Bushehr",
+	"081": "Hamadan",
+	"083": "Kermanshah",
+	"084": "Ilam",
+	"086": "Markazi",
+	"087": "Kurdistan",
+}
+
+// IsValidIranianPhone checks if the iranian phone number is valid and has a known area code.
+// A single dash or space between the area code and subscriber number is accepted (e.g. 021-12345678).
 func IsValidIranianPhone(phone string) bool {
+	_, ok := NormalizeIranianPhone(phone)
+	return ok
+}
+
+// NormalizeIranianPhone removes a single dash or space separator between the area code and subscriber number
+// of iranian landline number and reports whether the resulting 11-digit number is valid and has a known area code.
+func NormalizeIranianPhone(s string) (string, bool) {
+	phone := s
+	if len(phone) == 12 && (phone[3] == '-' || phone[3] == ' ') {
+		phone = phone[:3] + phone[4:]
+	}
+
 	re := regexp.MustCompile(`^0[1-9][0-9]{9}$`)
-	return re.MatchString(phone)
+	if !re.MatchString(phone) {
+		return "", false
+	}
+
+	if _, exists := IranianPhoneAreaCodes[phone[:3]]; !exists {
+		return "", false
+	}
+	return phone, true
+}
+
+// IranianPhoneProvince returns the province of a valid iranian landline number based on its area code.
+func IranianPhoneProvince(phone string) (string, bool) {
+	phone, ok := NormalizeIranianPhone(phone)
+	if !ok {
+		return "", false
+	}
+
+	province, exists := IranianPhoneAreaCodes[phone[:3]]
+	return province, exists
 }
 
 // IsValidIranianMobile checks if the iranian mobile number is valid.
@@ -66,16 +149,48 @@ func IsValidIranianMobile(mobile string) bool {
 	return re.MatchString(mobile)
 }
 
+// NormalizeIranianMobile converts iranian mobile number in 09xxxxxxxxx, +989xxxxxxxxx or 00989xxxxxxxxx form
+// to the canonical 09xxxxxxxxx form and reports whether the result is a valid mobile number.
+func NormalizeIranianMobile(s string) (string, bool) {
+	mobile := strings.TrimSpace(s)
+	for _, prefix := range []string{"+98", "0098"} {
+		if after, found := strings.CutPrefix(mobile, prefix); found {
+			mobile = "0" + after
+			break
+		}
+	}
+
+	if !IsValidIranianMobile(mobile) {
+		return "", false
+	}
+	return mobile, true
+}
+
+// IsE164 checks if the phone number is in E.164 format: a leading plus followed by 1 to 15 digits without separators.
+// The country code can't start with zero.
+func IsE164(s string) bool {
+	re := regexp.MustCompile(`^\+[1-9][0-9]{0,14}$`)
+	return re.MatchString(s)
+}
+
 // IsValidIranianPostalCode checks if the iranian postal code is valid.
+// Postal code must have 10 digits and only patterns impossible in the official scheme are rejected:
+// the first digit is never 0, the fifth digit is never 0 or 5 and all digits can't be the same.
 func IsValidIranianPostalCode(postalCode string) bool {
-	re := regexp.MustCompile(`^[0-9]{10}$`)
-	return re.MatchString(postalCode)
+	re := regexp.MustCompile(`^[1-9][0-9]{3}[1-46-9][0-9]{5}$`)
+	if !re.MatchString(postalCode) {
+		return false
+	}
+
+	return strings.Trim(postalCode, postalCode[:1]) != ""
 }
 
 // IsValidIranianIdNumber checks if the Iranian ID (birth certificate) number is valid.
+// Birth certificate numbers have no public checksum, so validation is based on length and range:
+// the number must have 1 to 10 digits (newer certificates equal the national code) and must not be zero.
 func IsValidIranianIdNumber(id string) bool {
 	re := regexp.MustCompile(`^[0-9]{1,10}$`)
-	return re.MatchString(id)
+	return re.MatchString(id) && strings.Trim(id, "0") != ""
 }
 
 // IsValidIranianNationalCode checks if the Iranian National ID number is valid using the official checksum algorithm.
@@ -108,20 +223,115 @@ func IsValidIranianNationalCode(nationalCode string) bool {
 	}
 }
 
+// NormalizeIranianNationalCode left-pads 8 or 9 digit national codes stored without leading zeros to 10 digits
+// and reports whether the result is a valid national code.
+func NormalizeIranianNationalCode(s string) (string, bool) {
+	code := strings.TrimSpace(s)
+	if len(code) < 8 || len(code) > 10 || !regexp.MustCompile(`^[0-9]+$`).MatchString(code) {
+		return "", false
+	}
+
+	code = strings.Repeat("0", 10-len(code)) + code
+	if !IsValidIranianNationalCode(code) {
+		return "", false
+	}
+	return code, true
+}
+
+// IranianNationalCodeCities maps the first three digits of iranian national codes to their issuing registry city.
+// Callers can extend or modify it before lookup.
+var IranianNationalCodeCities = map[string]string{
+	"001": "Tehran", "002": "Tehran", "003": "Tehran", "004": "Tehran",
+	"005": "Tehran", "006": "Tehran", "007": "Tehran", "008": "Tehran",
+	"092": "Mashhad", "093": "Mashhad", "094": "Mashhad",
+	"127": "Isfahan", "128": "Isfahan", "129": "Isfahan",
+	"136": "Tabriz", "137": "Tabriz", "138": "Tabriz",
+	"228": "Shiraz", "229": "Shiraz", "230": "Shiraz",
+}
+
+// IranianNationalCodeCity returns the issuing registry city of a valid iranian national code based on its prefix.
+func IranianNationalCodeCity(code string) (string, bool) {
+	if !IsValidIranianNationalCode(code) {
+		return "", false
+	}
+
+	city, exists := IranianNationalCodeCities[code[:3]]
+	return city, exists
+}
+
+// IsValidIranianCompanyID checks if the 11-digit Iranian legal entity national ID is valid using the official checksum algorithm.
+func IsValidIranianCompanyID(id string) bool {
+	// Company ID must be exactly 11 digits
+	re := regexp.MustCompile(`^[0-9]{11}$`)
+	if !re.MatchString(id) {
+		return false
+	}
+
+	// Registration part must not be all zeros
+	if id[3:9] == "000000" {
+		return false
+	}
+
+	// Checksum algorithm for Iranian legal entity national ID
+	coefficients := []int{29, 27, 23, 19, 17, 29, 27, 23, 19, 17}
+	decimal := int(id[9]-'0') + 2
+	sum := 0
+	for i, c := range coefficients {
+		sum += (int(id[i]-'0') + decimal) * c
+	}
+
+	remainder := sum % 11
+	if remainder == 10 {
+		remainder = 0
+	}
+	return int(id[10]-'0') == remainder
+}
+
+// Bank card validation errors returned by ValidateIranianBankCard.
+var (
+	ErrCardLength   = errors.New("card number must be exactly 16 digits")
+	ErrCardFormat   = errors.New("card number must contain only digits")
+	ErrCardChecksum = errors.New("card number checksum is invalid")
+)
+
 // IsValidIranianBankCard checks if the bank card number is valid using the Luhn algorithm.
 func IsValidIranianBankCard(cardNumber string) bool {
+	return ValidateIranianBankCard(cardNumber) == nil
+}
+
+// ValidateIranianBankCard validates the bank card number and returns the reason of failure.
+// It returns ErrCardLength, ErrCardFormat, ErrCardChecksum or nil if card number is valid.
+func ValidateIranianBankCard(cardNumber string) error {
 	// Check if the card number is exactly 16 digits
+	if len(cardNumber) != 16 {
+		return ErrCardLength
+	}
 	re := regexp.MustCompile(`^[0-9]{16}$`)
 	if !re.MatchString(cardNumber) {
-		return false
+		return ErrCardFormat
 	}
 
 	// Luhn algorithm for card validation
+	if !LuhnValid(cardNumber) {
+		return ErrCardChecksum
+	}
+	return nil
+}
+
+// LuhnValid checks if the digit string passes the Luhn checksum algorithm.
+// It works for any length of digits (e.g. card numbers, IMEI).
+func LuhnValid(digits string) bool {
+	// Check if the input contains only digits
+	re := regexp.MustCompile(`^[0-9]+$`)
+	if !re.MatchString(digits) {
+		return false
+	}
+
 	sum := 0
 	alternate := false
 
-	for i := len(cardNumber) - 1; i >= 0; i-- {
-		n, _ := strconv.Atoi(string(cardNumber[i]))
+	for i := len(digits) - 1; i >= 0; i-- {
+		n, _ := strconv.Atoi(string(digits[i]))
 		if alternate {
 			n *= 2
 			if n > 9 {
@@ -148,8 +358,8 @@ func IsValidIranianIBAN(iban string) bool {
 		return false
 	}
 
-	// Convert IBAN to a numeric format for MOD 97 check
-	ibanNumeric := iban[2:] + "1827" // IR -> 1827
+	// Move country code and check digits to the end and convert to numeric format for MOD 97 check
+	ibanNumeric := iban[4:] + "1827" + iban[2:4] // IR -> 1827
 
 	// Convert the numeric string to a big integer
 	bigInt, success := new(big.Int).SetString(ibanNumeric, 10)
@@ -161,6 +371,155 @@ func IsValidIranianIBAN(iban string) bool {
 	return new(big.Int).Mod(bigInt, big.NewInt(97)).Cmp(big.NewInt(1)) == 0
 }
 
+// IBANLengths maps ISO 13616 country codes to their IBAN length.
+// Callers can extend or modify it before validation.
+var IBANLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BR": 29,
+	"CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24,
+	"FI": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28, "HR": 21,
+	"HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IR": 26, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20,
+	"LB": 28, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MR": 27,
+	"MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24,
+	"RS": 22, "SA": 24, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "TN": 24, "TR": 26, "UA": 29, "VG": 24,
+	"XK": 20,
+}
+
+// IsValidIBAN checks if the IBAN is valid using the generic ISO 13616 MOD-97 check and the country length from IBANLengths.
+// Spaces are ignored and letters are case-insensitive. Unknown countries are rejected.
+func IsValidIBAN(iban string) bool {
+	iban = strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+	if !regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`).MatchString(iban) {
+		return false
+	}
+
+	// Check country specific length
+	if length, ok := IBANLengths[iban[:2]]; !ok || len(iban) != length {
+		return false
+	}
+
+	// Move country code and check digits to the end and compute MOD 97 digit by digit (A=10 ... Z=35)
+	remainder := 0
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder == 1
+}
+
+// IranianPlateLetters contains the persian letters allowed on iranian vehicle license plates.
+var IranianPlateLetters = []string{
+	"الف", "ب", "پ", "ت", "ث", "ج", "د", "ز", "ژ", "س", "ش",
+	"ص", "ط", "ع", "ف", "ق", "ک", "گ", "ل", "م", "ن", "و", "ه", "ی",
+}
+
+// IsValidIranianPlate checks if the iranian vehicle license plate is valid.
+// Plate must be in normalized form "NNLNNN-PP" without spaces (e.g. 12ب345-67), where NN is two digits,
+// L is a plate letter, NNN is three digits and PP is the two-digit province code between 10 and 99.
+func IsValidIranianPlate(plate string) bool {
+	re := regexp.MustCompile(`^([1-9][0-9])(\p{Arabic}+)([1-9][0-9]{2})-([1-9][0-9])$`)
+	parts := re.FindStringSubmatch(plate)
+	if parts == nil {
+		return false
+	}
+
+	// Validate the plate letter
+	for _, letter := range IranianPlateLetters {
+		if parts[2] == letter {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeIranianIBAN returns the canonical 26-character form of the iranian IBAN (e.g. IR820540102680020817909002).
+// Spaces are removed, letters are uppercased and "IR" prefix is added if missing.
+func NormalizeIranianIBAN(iban string) (string, bool) {
+	iban = strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+	if !strings.HasPrefix(iban, "IR") {
+		iban = "IR" + iban
+	}
+
+	if !IsValidIranianIBAN(iban) {
+		return "", false
+	}
+	return iban, true
+}
+
+// FormatIranianIBAN returns the iranian IBAN grouped in blocks of four for display (e.g. IR82 0540 1026 8002 0817 9090 02).
+// Input is normalized by NormalizeIranianIBAN first, so spaced, lowercase and prefix-less forms are accepted.
+func FormatIranianIBAN(iban string) (string, bool) {
+	iban, ok := NormalizeIranianIBAN(iban)
+	if !ok {
+		return "", false
+	}
+
+	blocks := make([]string, 0, (len(iban)+3)/4)
+	for i := 0; i < len(iban); i += 4 {
+		blocks = append(blocks, iban[i:min(i+4, len(iban))])
+	}
+	return strings.Join(blocks, " "), true
+}
+
+// IranianIBANBanks maps iranian bank codes embedded in IBAN to bank names.
+// Callers can extend or modify it before validation.
+var IranianIBANBanks = map[string]string{
+	"010": "Central Bank of Iran",
+	"011": "Sanat va Madan",
+	"012": "Mellat",
+	"013": "Refah Kargaran",
+	"014": "Maskan",
+	"015": "Sepah",
+	"016": "Keshavarzi",
+	"017": "Melli",
+	"018": "Tejarat",
+	"019": "Saderat",
+	"020": "Tose'e Saderat",
+	"021": "Post Bank",
+	"022": "Tose'e Ta'avon",
+	"051": "Tose'e Credit Institution",
+	"053": "Karafarin",
+	"054": "Parsian",
+	"055": "Eghtesad Novin",
+	"056": "Saman",
+	"057": "Pasargad",
+	"058": "Sarmayeh",
+	"059": "Sina",
+	"060": "Mehr Iran",
+	"061": "Shahr",
+	"062": "Ayandeh",
+	"064": "Gardeshgari",
+	"065": "Hekmat Iranian",
+	"066": "Dey",
+	"069": "Iran Zamin",
+	"070": "Resalat",
+	"073": "Kosar Credit Institution",
+	"075": "Melal Credit Institution",
+	"078": "Khavarmianeh",
+	"080": "Noor Credit Institution",
+	"095": "Iran Venezuela",
+}
+
+// IranianIBANBank returns the bank name of a valid iranian IBAN based on its embedded bank code.
+func IranianIBANBank(iban string) (string, bool) {
+	if !IsValidIranianIBAN(iban) {
+		return "", false
+	}
+
+	// Bank code follows the country code and check digits
+	iban = strings.TrimPrefix(iban, "IR")
+	bank, exists := IranianIBANBanks[iban[2:5]]
+	return bank, exists
+}
+
+// IsValidIranianIBANStrict checks if the iranian IBAN is valid and its embedded bank code belongs to a known bank.
+func IsValidIranianIBANStrict(iban string) bool {
+	_, ok := IranianIBANBank(iban)
+	return ok
+}
+
 // IsValidIP checks if the given string is a valid IP address (IPv4 or IPv6).
 func IsValidIP(ip string) bool {
 	// Try to parse the IP address using net package
@@ -168,6 +527,28 @@ func IsValidIP(ip string) bool {
 	return parsedIP != nil
 }
 
+// IsValidIPVersion checks if the given string is a valid IP address of the given version.
+// Version 4 or 6 constrains the address family and 0 accepts either, other versions are rejected.
+// IPv4-mapped IPv6 addresses (e.g. ::ffff:1.2.3.4) are treated as IPv6.
+func IsValidIPVersion(ip string, version int) bool {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return false
+	}
+
+	isV4 := parsedIP.To4() != nil && !strings.Contains(ip, ":")
+	switch version {
+	case 0:
+		return true
+	case 4:
+		return isV4
+	case 6:
+		return !isV4
+	default:
+		return false
+	}
+}
+
 // IsValidIPPort checks if the given IP:Port string is valid.
 func IsValidIPPort(ipPort string) bool {
 	// Split the IP:Port string by ":"
@@ -197,10 +578,24 @@ func IsValidIPPort(ipPort string) bool {
 	return true
 }
 
+// FileSize returns the size of the file.
+func FileSize(file *multipart.FileHeader) bytesize.ByteSize {
+	return bytesize.ByteSize(file.Size)
+}
+
+// FileMIME returns the MIME type of the file detected from its content.
+func FileMIME(file *multipart.FileHeader) (string, error) {
+	detected, err := detectMIME(file)
+	if err != nil {
+		return "", err
+	}
+	return detected.String(), nil
+}
+
 // IsValidFileSize checks if the file size is within the given min and max size.
 func IsValidFileSize(file *multipart.FileHeader, min string, max string) (bool, error) {
 	// Get the file size
-	fileSize := file.Size
+	fileSize := FileSize(file)
 
 	// Convert min and max to bytes using go-bytesize
 	minSize, err := bytesize.Parse(min)
@@ -213,31 +608,491 @@ func IsValidFileSize(file *multipart.FileHeader, min string, max string) (bool,
 	}
 
 	// Check if the file size is within the min and max size range
-	return fileSize >= int64(minSize) && fileSize <= int64(maxSize), nil
+	return fileSize >= minSize && fileSize <= maxSize, nil
+}
+
+// AreValidFileSizes checks if the size of every file is within the given min and max size.
+// It stops on the first invalid file, errors are annotated with the failed file index.
+func AreValidFileSizes(files []*multipart.FileHeader, min, max string) (bool, error) {
+	for i, file := range files {
+		ok, err := IsValidFileSize(file, min, max)
+		if err != nil {
+			return false, fmt.Errorf("file %d: %w", i, err)
+		} else if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 // IsValidFileType checks if the MIME type of the file matches any of the provided valid MIME types
 func IsValidFileType(file *multipart.FileHeader, mimes ...string) (bool, error) {
-	// Open the file to read its content and determine MIME type
-	f, err := file.Open()
+	// Get MIME type from file content
+	detected, err := FileMIME(file)
 	if err != nil {
 		return false, err
 	}
-	defer f.Close()
 
-	// Get MIME type using mimetype package
-	mime, err := mimetype.DetectReader(f)
+	// Compare detected MIME type with allowed MIME types
+	for _, mimeType := range mimes {
+		if strings.ToLower(detected) == strings.ToLower(mimeType) {
+			return true, nil
+		}
+	}
+
+	// If MIME type doesn't match any of the allowed types, return false
+	return false, nil
+}
+
+// IsValidFileTypeStrict checks if the MIME type of the file matches any of the provided valid MIME types
+// and the file name extension agrees with the type detected from content.
+func IsValidFileTypeStrict(file *multipart.FileHeader, mimes ...string) (bool, error) {
+	// Get MIME type from file content
+	detected, err := detectMIME(file)
 	if err != nil {
 		return false, err
 	}
 
 	// Compare detected MIME type with allowed MIME types
+	allowed := false
 	for _, mimeType := range mimes {
-		if strings.ToLower(mime.String()) == strings.ToLower(mimeType) {
-			return true, nil
+		if strings.EqualFold(detected.String(), mimeType) {
+			allowed = true
+			break
 		}
 	}
+	if !allowed {
+		return false, nil
+	}
 
-	// If MIME type doesn't match any of the allowed types, return false
+	// File must have an extension
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext == "" {
+		return false, nil
+	}
+
+	// Compare extension with detected MIME type or its known aliases
+	if strings.EqualFold(detected.Extension(), ext) {
+		return true, nil
+	}
+	if byExt, _, err := mime.ParseMediaType(mime.TypeByExtension(ext)); err == nil {
+		for m := detected; m != nil; m = m.Parent() {
+			if m.Is(byExt) {
+				return true, nil
+			}
+		}
+	}
 	return false, nil
 }
+
+// detectMIME opens the file and detects its MIME type from content.
+func detectMIME(file *multipart.FileHeader) (*mimetype.MIME, error) {
+	// Open the file to read its content and determine MIME type
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// Get MIME type using mimetype package
+	return mimetype.DetectReader(f)
+}
+
+// AreValidFileTypes checks if the MIME type of every file matches any of the provided valid MIME types.
+// It stops on the first invalid file, errors are annotated with the failed file index.
+func AreValidFileTypes(files []*multipart.FileHeader, mimes ...string) (bool, error) {
+	for i, file := range files {
+		ok, err := IsValidFileType(file, mimes...)
+		if err != nil {
+			return false, fmt.Errorf("file %d: %w", i, err)
+		} else if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// IsValidJSON checks if the string is a well-formed JSON document. Empty strings are rejected.
+func IsValidJSON(s string) bool {
+	return strings.TrimSpace(s) != "" && json.Valid([]byte(s))
+}
+
+// IsValidJSONPatch checks if the string is a valid RFC 6902 JSON Patch document.
+// Each operation must have a known "op", a "path" and the "value" or "from" member required by its op.
+func IsValidJSONPatch(s string) bool {
+	// Patch document must be an array of operation objects
+	var operations []map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &operations); err != nil || operations == nil {
+		return false
+	}
+
+	// Validate each operation
+	for _, operation := range operations {
+		if operation == nil {
+			return false
+		}
+
+		// Resolve operation name
+		var op string
+		if raw, ok := operation["op"]; !ok || json.Unmarshal(raw, &op) != nil {
+			return false
+		}
+
+		// Path is required for all operations
+		if !isJSONPointerMember(operation, "path") {
+			return false
+		}
+
+		// Check op specific members
+		switch op {
+		case "remove":
+		case "add", "replace", "test":
+			if _, ok := operation["value"]; !ok {
+				return false
+			}
+		case "move", "copy":
+			if !isJSONPointerMember(operation, "from") {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// isJSONPointerMember checks if the member exists on the object and is a valid JSON pointer string.
+func isJSONPointerMember(object map[string]json.RawMessage, member string) bool {
+	raw, ok := object[member]
+	if !ok {
+		return false
+	}
+
+	var pointer string
+	if err := json.Unmarshal(raw, &pointer); err != nil {
+		return false
+	}
+	return pointer == "" || strings.HasPrefix(pointer, "/")
+}
+
+// IsValidURL checks if the string is an absolute URL with a host.
+// If schemes are provided, the URL scheme must match one of them (case-insensitive).
+func IsValidURL(s string, schemes ...string) bool {
+	// Parse and reject relative URLs or URLs without host
+	u, err := url.Parse(s)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return false
+	}
+
+	// Accept any scheme if no scheme provided
+	if len(schemes) == 0 {
+		return true
+	}
+
+	// Compare URL scheme with allowed schemes
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// FileHash computes the hex encoded checksum of the file content using the given algorithm.
+// Supported algorithms are md5, sha1, sha256 and sha512. File content is streamed into the hasher.
+func FileHash(file *multipart.FileHeader, algo string) (string, error) {
+	// Resolve hasher from algorithm name
+	var h hash.Hash
+	switch strings.ToLower(strings.TrimSpace(algo)) {
+	case "md5":
+		h = md5.New()
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return "", fmt.Errorf("unsupported hash algorithm %q", algo)
+	}
+
+	// Open the file and stream its content into hasher
+	f, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// IsLatitude checks if the string is a numeric latitude within [-90, 90] range.
+func IsLatitude(s string) bool {
+	lat, err := strconv.ParseFloat(s, 64)
+	return err == nil && lat >= -90 && lat <= 90
+}
+
+// IsLongitude checks if the string is a numeric longitude within [-180, 180] range.
+func IsLongitude(s string) bool {
+	lng, err := strconv.ParseFloat(s, 64)
+	return err == nil && lng >= -180 && lng <= 180
+}
+
+// IsPercentage checks if the string is a plain decimal number within [0, 100] range (e.g. 50 or 12.5).
+// If allowSymbol is true, an optional trailing percent sign is accepted (e.g. 50%).
+func IsPercentage(s string, allowSymbol bool) bool {
+	if allowSymbol {
+		s = strings.TrimSuffix(s, "%")
+	}
+
+	re := regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+	if !re.MatchString(s) {
+		return false
+	}
+
+	n, err := strconv.ParseFloat(s, 64)
+	return err == nil && n <= 100
+}
+
+// HaversineDistance returns the great-circle distance in kilometers between two coordinates.
+func HaversineDistance(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadiusKm = 6371.0
+
+	// Convert degrees to radians
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+
+	// Haversine formula
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return earthRadiusKm * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// IsUUID checks if the string is a UUID in canonical 8-4-4-4-12 hex form.
+// If version is not 0, the UUID version nibble must match it.
+func IsUUID(s string, version int) bool {
+	re := regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	if !re.MatchString(s) {
+		return false
+	}
+
+	// Version is the first hex digit of the third group
+	if version == 0 {
+		return true
+	}
+	v, err := strconv.ParseInt(s[14:15], 16, 64)
+	return err == nil && int(v) == version
+}
+
+// IsValidPrefixedUUID checks if the string is a prefixed identifier in the form of "<prefix>_<uuid>".
+func IsValidPrefixedUUID(s, prefix string) bool {
+	// Check and strip the prefix
+	if prefix == "" || !strings.HasPrefix(s, prefix+"_") {
+		return false
+	}
+
+	// Validate the UUID suffix
+	return IsUUID(strings.TrimPrefix(s, prefix+"_"), 0)
+}
+
+// ClosestTerm returns the vocabulary term with the smallest Levenshtein distance to s.
+// It returns false if no term is within maxDistance edits.
+func ClosestTerm(s string, vocab []string, maxDistance int) (string, bool) {
+	closest, best := "", -1
+	for _, term := range vocab {
+		d := levenshtein(s, term)
+		if d <= maxDistance && (best < 0 || d < best) {
+			closest, best = term, d
+		}
+	}
+	return closest, best >= 0
+}
+
+// levenshtein computes the edit distance between two strings on rune basis.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Keep only previous and current rows of the distance matrix
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// IsJSONNumber checks if the string is a valid JSON number literal.
+// Integers, decimals and exponents are accepted, leading zeros, Infinity and NaN are rejected.
+func IsJSONNumber(s string) bool {
+	re := regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+	return re.MatchString(s)
+}
+
+// IsJSONBool checks if the string is a valid JSON boolean literal (true or false).
+func IsJSONBool(s string) bool {
+	return s == "true" || s == "false"
+}
+
+// IsBase64 checks if the string is valid base64 encoded data.
+// Standard variant requires padding, URL-safe variant accepts both padded and unpadded input.
+func IsBase64(s string, urlSafe bool) bool {
+	if s == "" {
+		return false
+	}
+
+	var err error
+	switch {
+	case !urlSafe:
+		_, err = base64.StdEncoding.Strict().DecodeString(s)
+	case strings.HasSuffix(s, "="):
+		_, err = base64.URLEncoding.Strict().DecodeString(s)
+	default:
+		_, err = base64.RawURLEncoding.Strict().DecodeString(s)
+	}
+	return err == nil
+}
+
+// IsISBN10 checks if the string is a valid ISBN-10 with checksum. Hyphens are ignored and last digit may be X.
+func IsISBN10(s string) bool {
+	s = strings.ReplaceAll(s, "-", "")
+	re := regexp.MustCompile(`^[0-9]{9}[0-9X]$`)
+	if !re.MatchString(s) {
+		return false
+	}
+
+	// Weighted sum from 10 down to 1 must be divisible by 11
+	sum := 0
+	for i := 0; i < 10; i++ {
+		n := int(s[i] - '0')
+		if s[i] == 'X' {
+			n = 10
+		}
+		sum += n * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+// IsISBN13 checks if the string is a valid ISBN-13 with checksum. Hyphens are ignored.
+func IsISBN13(s string) bool {
+	s = strings.ReplaceAll(s, "-", "")
+	re := regexp.MustCompile(`^97[89][0-9]{10}$`)
+	if !re.MatchString(s) {
+		return false
+	}
+
+	// Alternating 1 and 3 weighted sum must be divisible by 10
+	sum := 0
+	for i := 0; i < 13; i++ {
+		n := int(s[i] - '0')
+		if i%2 == 1 {
+			n *= 3
+		}
+		sum += n
+	}
+	return sum%10 == 0
+}
+
+// IsHexColor checks if the string is a hex color in #RGB, #RGBA, #RRGGBB or #RRGGBBAA form.
+func IsHexColor(s string) bool {
+	re := regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	return re.MatchString(s)
+}
+
+// IsSemVer checks if the string is a valid semantic version 2.0.0 (major.minor.patch with optional prerelease and build metadata).
+func IsSemVer(s string) bool {
+	re := regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+	return re.MatchString(s)
+}
+
+// IsSlug checks if the string is a URL slug of lowercase letters and digits separated by single hyphens.
+func IsSlug(s string) bool {
+	re := regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	return re.MatchString(s)
+}
+
+// IsDomain checks if the string is a valid domain name per RFC 1035.
+// Labels must be at most 63 characters of letters, digits and hyphens without leading or trailing hyphen,
+// and total length must be at most 253 characters. Punycode (xn--) labels are accepted.
+func IsDomain(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+
+	re := regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	for _, label := range strings.Split(s, ".") {
+		if !re.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsTimeOfDay checks if the string is a zero-padded 24-hour time in HH:MM form (e.g. 09:30).
+// If allowSeconds is true, HH:MM:SS form is also accepted. Single-digit hours and 24:00 are rejected.
+func IsTimeOfDay(s string, allowSeconds bool) bool {
+	pattern := `^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	if allowSeconds {
+		pattern = `^([01][0-9]|2[0-3]):[0-5][0-9](:[0-5][0-9])?$`
+	}
+	return regexp.MustCompile(pattern).MatchString(s)
+}
+
+// IsDuration checks if the string is a valid go duration with units (e.g. 500ms, 1h30m).
+// Empty strings and bare numbers without units (including 0) are rejected.
+func IsDuration(s string) bool {
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return false
+	}
+
+	_, err := time.ParseDuration(s)
+	return err == nil
+}
+
+// IsCardExpiry checks if the string is a card expiry date in MM/YY or MM/YYYY form that is not in the past.
+// Cards expire at the end of the given month, so the current month is valid.
+// Two-digit years are assumed to be in the 2000s (e.g. 05/29 is May 2029).
+func IsCardExpiry(s string) bool {
+	re := regexp.MustCompile(`^(0[1-9]|1[0-2])/([0-9]{2}|[0-9]{4})$`)
+	parts := re.FindStringSubmatch(s)
+	if parts == nil {
+		return false
+	}
+
+	month, _ := strconv.Atoi(parts[1])
+	year, _ := strconv.Atoi(parts[2])
+	if len(parts[2]) == 2 {
+		year += 2000
+	}
+
+	now := time.Now()
+	return year > now.Year() || (year == now.Year() && month >= int(now.Month()))
+}
+
+// IsNotCommonPassword checks that the password is not in the blocklist, ignoring case and surrounding spaces.
+// Blocklist keys must be lowercase and trimmed. The caller supplies the list (e.g. top 10k common passwords).
+func IsNotCommonPassword(s string, blocklist map[string]struct{}) bool {
+	_, blocked := blocklist[strings.ToLower(strings.TrimSpace(s))]
+	return !blocked
+}
@@ -0,0 +1,932 @@
+package funcs_test
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mekramy/govalidator/funcs"
+)
+
+// newTestFile creates a multipart file header with given name and content.
+func newTestFile(t *testing.T, name string, content []byte) *multipart.FileHeader {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := part.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return form.File["file"][0]
+}
+
+func TestIsValidJSONPatch(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		patch := `[
+			{"op": "add", "path": "/name", "value": "john"},
+			{"op": "remove", "path": "/age"},
+			{"op": "move", "from": "/first", "path": "/last"}
+		]`
+		if !funcs.IsValidJSONPatch(patch) {
+			t.Fatal("expected valid patch")
+		}
+	})
+
+	t.Run("MissingPath", func(t *testing.T) {
+		patch := `[{"op": "add", "value": "john"}]`
+		if funcs.IsValidJSONPatch(patch) {
+			t.Fatal("expected invalid patch for missing path")
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		if funcs.IsValidJSONPatch("null") || funcs.IsValidJSONPatch(" null ") {
+			t.Fatal("expected invalid patch for null")
+		}
+		if !funcs.IsValidJSONPatch("[]") {
+			t.Fatal("expected empty patch to be valid")
+		}
+	})
+
+	t.Run("UnknownOp", func(t *testing.T) {
+		patch := `[{"op": "merge", "path": "/name", "value": "john"}]`
+		if funcs.IsValidJSONPatch(patch) {
+			t.Fatal("expected invalid patch for unknown op")
+		}
+	})
+
+	t.Run("NotArray", func(t *testing.T) {
+		if funcs.IsValidJSONPatch(`{"op": "remove", "path": "/age"}`) {
+			t.Fatal("expected invalid patch for non-array document")
+		}
+	})
+}
+
+func TestIsValidURL(t *testing.T) {
+	t.Run("AllowedSchemes", func(t *testing.T) {
+		if !funcs.IsValidURL("http://example.com", "https", "http") {
+			t.Fatal("expected http URL to be valid")
+		}
+		if !funcs.IsValidURL("HTTPS://example.com/hook", "https") {
+			t.Fatal("expected https URL to be valid")
+		}
+	})
+
+	t.Run("RejectedScheme", func(t *testing.T) {
+		if funcs.IsValidURL("ftp://example.com", "https", "http") {
+			t.Fatal("expected ftp URL to be rejected")
+		}
+	})
+
+	t.Run("Relative", func(t *testing.T) {
+		if funcs.IsValidURL("/path/to/hook") {
+			t.Fatal("expected relative URL to be rejected")
+		}
+		if funcs.IsValidURL("mailto:john@example.com") {
+			t.Fatal("expected URL without host to be rejected")
+		}
+	})
+}
+
+func TestAreValidFileSizes(t *testing.T) {
+	small := newTestFile(t, "small.txt", bytes.Repeat([]byte("a"), 10))
+	large := newTestFile(t, "large.txt", bytes.Repeat([]byte("a"), 2048))
+
+	t.Run("Valid", func(t *testing.T) {
+		ok, err := funcs.AreValidFileSizes([]*multipart.FileHeader{small, small}, "1B", "1KB")
+		if err != nil {
+			t.Fatal(err)
+		} else if !ok {
+			t.Fatal("expected all files to be valid")
+		}
+	})
+
+	t.Run("Mixed", func(t *testing.T) {
+		ok, err := funcs.AreValidFileSizes([]*multipart.FileHeader{small, large}, "1B", "1KB")
+		if err != nil {
+			t.Fatal(err)
+		} else if ok {
+			t.Fatal("expected large file to fail")
+		}
+	})
+
+	t.Run("InvalidSize", func(t *testing.T) {
+		_, err := funcs.AreValidFileSizes([]*multipart.FileHeader{small}, "1B", "huge")
+		if err == nil {
+			t.Fatal("expected error for invalid size")
+		}
+	})
+}
+
+func TestAreValidFileTypes(t *testing.T) {
+	text := newTestFile(t, "note.txt", []byte("plain text content"))
+	png := newTestFile(t, "image.png", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"))
+
+	t.Run("Valid", func(t *testing.T) {
+		ok, err := funcs.AreValidFileTypes([]*multipart.FileHeader{text, text}, "text/plain; charset=utf-8")
+		if err != nil {
+			t.Fatal(err)
+		} else if !ok {
+			t.Fatal("expected all files to be valid")
+		}
+	})
+
+	t.Run("Mixed", func(t *testing.T) {
+		ok, err := funcs.AreValidFileTypes([]*multipart.FileHeader{png, text}, "image/png")
+		if err != nil {
+			t.Fatal(err)
+		} else if ok {
+			t.Fatal("expected text file to fail")
+		}
+	})
+}
+
+func TestIsValidFileTypeStrict(t *testing.T) {
+	pngContent := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	t.Run("Matching", func(t *testing.T) {
+		ok, err := funcs.IsValidFileTypeStrict(newTestFile(t, "image.png", pngContent), "image/png")
+		if err != nil {
+			t.Fatal(err)
+		} else if !ok {
+			t.Fatal("expected matching extension to be valid")
+		}
+	})
+
+	t.Run("MismatchedExtension", func(t *testing.T) {
+		ok, err := funcs.IsValidFileTypeStrict(newTestFile(t, "image.jpg", pngContent), "image/png")
+		if err != nil {
+			t.Fatal(err)
+		} else if ok {
+			t.Fatal("expected mismatched extension to be rejected")
+		}
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		ok, err := funcs.IsValidFileTypeStrict(newTestFile(t, "data.xyz", []byte{0x00, 0x01, 0x02, 0xff}), "application/octet-stream")
+		if err != nil {
+			t.Fatal(err)
+		} else if ok {
+			t.Fatal("expected unknown type to be rejected")
+		}
+	})
+
+	t.Run("Lenient", func(t *testing.T) {
+		ok, err := funcs.IsValidFileType(newTestFile(t, "image.jpg", pngContent), "image/png")
+		if err != nil {
+			t.Fatal(err)
+		} else if !ok {
+			t.Fatal("expected lenient check to ignore extension")
+		}
+	})
+}
+
+func TestIsValidPrefixedUUID(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		if !funcs.IsValidPrefixedUUID("cus_3f2504e0-4f89-41d3-9a0c-0305e82c3301", "cus") {
+			t.Fatal("expected prefixed UUID to be valid")
+		}
+	})
+
+	t.Run("WrongPrefix", func(t *testing.T) {
+		if funcs.IsValidPrefixedUUID("ord_3f2504e0-4f89-41d3-9a0c-0305e82c3301", "cus") {
+			t.Fatal("expected wrong prefix to be rejected")
+		}
+	})
+
+	t.Run("InvalidUUID", func(t *testing.T) {
+		if funcs.IsValidPrefixedUUID("cus_3f2504e0-4f89-41d3-9a0c", "cus") {
+			t.Fatal("expected invalid UUID suffix to be rejected")
+		}
+	})
+}
+
+func TestFileSizeAndMIME(t *testing.T) {
+	content := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	file := newTestFile(t, "image.png", content)
+
+	if size := funcs.FileSize(file); int64(size) != file.Size || int(size) != len(content) {
+		t.Fatalf("expected size %d, got %d", len(content), int64(size))
+	}
+
+	mime, err := funcs.FileMIME(file)
+	if err != nil {
+		t.Fatal(err)
+	} else if mime != "image/png" {
+		t.Fatalf("expected image/png, got %s", mime)
+	}
+}
+
+func TestClosestTerm(t *testing.T) {
+	vocab := []string{"electronics", "books", "clothing"}
+
+	t.Run("ExactMatch", func(t *testing.T) {
+		if term, ok := funcs.ClosestTerm("books", vocab, 1); !ok || term != "books" {
+			t.Fatalf("expected books, got %q", term)
+		}
+	})
+
+	t.Run("Typo", func(t *testing.T) {
+		if term, ok := funcs.ClosestTerm("bokks", vocab, 1); !ok || term != "books" {
+			t.Fatalf("expected books, got %q", term)
+		}
+	})
+
+	t.Run("FarOff", func(t *testing.T) {
+		if term, ok := funcs.ClosestTerm("furniture", vocab, 2); ok {
+			t.Fatalf("expected no match, got %q", term)
+		}
+	})
+}
+
+func TestIsValidIranianPhone(t *testing.T) {
+	t.Run("Tehran", func(t *testing.T) {
+		if !funcs.IsValidIranianPhone("02112345678") {
+			t.Fatal("expected Tehran phone to be valid")
+		}
+	})
+
+	t.Run("Isfahan", func(t *testing.T) {
+		if !funcs.IsValidIranianPhone("03132345678") {
+			t.Fatal("expected Isfahan phone to be valid")
+		}
+	})
+
+	t.Run("InvalidAreaCode", func(t *testing.T) {
+		if funcs.IsValidIranianPhone("02912345678") {
+			t.Fatal("expected unknown area code to be rejected")
+		}
+		if funcs.IsValidIranianPhone("09912345678") {
+			t.Fatal("expected mobile prefix to be rejected")
+		}
+	})
+}
+
+func TestNormalizeIranianPhone(t *testing.T) {
+	for _, phone := range []string{"021-12345678", "021 12345678", "02112345678"} {
+		if normalized, ok := funcs.NormalizeIranianPhone(phone); !ok || normalized != "02112345678" {
+			t.Fatalf("expected %q to normalize to 02112345678, got %q", phone, normalized)
+		}
+		if !funcs.IsValidIranianPhone(phone) {
+			t.Fatalf("expected %q to be valid", phone)
+		}
+	}
+
+	for _, phone := range []string{"021--12345678", "0211-2345678", "021_12345678", "029-12345678", "021-1234567"} {
+		if _, ok := funcs.NormalizeIranianPhone(phone); ok {
+			t.Fatalf("expected %q to be rejected", phone)
+		}
+	}
+}
+
+func TestIranianPhoneProvince(t *testing.T) {
+	cases := map[string]string{
+		"02112345678": "Tehran",
+		"03132345678": "Isfahan",
+		"05138765432": "Razavi Khorasan",
+		"07132345678": "Fars",
+		"04135551234": "East Azerbaijan",
+	}
+	for phone, expected := range cases {
+		if province, ok := funcs.IranianPhoneProvince(phone); !ok || province != expected {
+			t.Fatalf("expected %s for %s, got %q", expected, phone, province)
+		}
+	}
+
+	if _, ok := funcs.IranianPhoneProvince("02912345678"); ok {
+		t.Fatal("expected unknown area code to fail")
+	}
+	if _, ok := funcs.IranianPhoneProvince("0211234"); ok {
+		t.Fatal("expected invalid phone to fail")
+	}
+}
+
+func TestIsValidIranianPostalCode(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		if !funcs.IsValidIranianPostalCode("1193653471") {
+			t.Fatal("expected realistic postal code to be valid")
+		}
+	})
+
+	t.Run("LeadingZero", func(t *testing.T) {
+		if funcs.IsValidIranianPostalCode("0193653471") {
+			t.Fatal("expected leading zero to be rejected")
+		}
+	})
+
+	t.Run("ImpossibleFifthDigit", func(t *testing.T) {
+		for _, code := range []string{"1193053471", "1193553471"} {
+			if funcs.IsValidIranianPostalCode(code) {
+				t.Fatalf("expected %s to be rejected", code)
+			}
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		for _, code := range []string{"1111111111", "119365347", "119365347a"} {
+			if funcs.IsValidIranianPostalCode(code) {
+				t.Fatalf("expected %s to be rejected", code)
+			}
+		}
+	})
+}
+
+func TestIsValidIranianIdNumber(t *testing.T) {
+	for _, id := range []string{"1", "12345", "0012345678"} {
+		if !funcs.IsValidIranianIdNumber(id) {
+			t.Fatalf("expected %s to be valid", id)
+		}
+	}
+
+	for _, id := range []string{"0", "0000", "", "12345678901", "12a45"} {
+		if funcs.IsValidIranianIdNumber(id) {
+			t.Fatalf("expected %s to be invalid", id)
+		}
+	}
+}
+
+func TestNormalizeIranianNationalCode(t *testing.T) {
+	cases := map[string]string{
+		"0921234562": "0921234562",
+		"921234562":  "0921234562",
+		"12345679":   "0012345679",
+	}
+	for code, expected := range cases {
+		if res, ok := funcs.NormalizeIranianNationalCode(code); !ok || res != expected {
+			t.Fatalf("expected %s for %s, got %q", expected, code, res)
+		}
+	}
+
+	for _, code := range []string{"921234563", "1234567", "09212345620", "92123456a"} {
+		if _, ok := funcs.NormalizeIranianNationalCode(code); ok {
+			t.Fatalf("expected %s to be invalid", code)
+		}
+	}
+}
+
+func TestIranianNationalCodeCity(t *testing.T) {
+	cases := map[string]string{
+		"0012345679": "Tehran",
+		"0921234562": "Mashhad",
+		"1276543212": "Isfahan",
+		"2283334446": "Shiraz",
+	}
+	for code, expected := range cases {
+		if city, ok := funcs.IranianNationalCodeCity(code); !ok || city != expected {
+			t.Fatalf("expected %s for %s, got %q", expected, code, city)
+		}
+	}
+
+	if _, ok := funcs.IranianNationalCodeCity("9991234561"); ok {
+		t.Fatal("expected unknown prefix to fail")
+	}
+	if _, ok := funcs.IranianNationalCodeCity("0012345678"); ok {
+		t.Fatal("expected invalid code to fail")
+	}
+}
+
+func TestNormalizeIranianMobile(t *testing.T) {
+	for _, mobile := range []string{"09123456789", "+989123456789", "00989123456789"} {
+		if res, ok := funcs.NormalizeIranianMobile(mobile); !ok || res != "09123456789" {
+			t.Fatalf("expected 09123456789 for %s, got %q", mobile, res)
+		}
+	}
+
+	for _, mobile := range []string{"9123456789", "+98912345678", "+18123456789", "0989123456789"} {
+		if _, ok := funcs.NormalizeIranianMobile(mobile); ok {
+			t.Fatalf("expected %s to be invalid", mobile)
+		}
+	}
+}
+
+func TestIsE164(t *testing.T) {
+	for _, phone := range []string{"+14155552671", "+989123456789", "+123456789012345"} {
+		if !funcs.IsE164(phone) {
+			t.Fatalf("expected %s to be valid", phone)
+		}
+	}
+
+	for _, phone := range []string{"+1234567890123456", "+1-415-555-2671", "+1 4155552671", "14155552671", "+04155552671", "+"} {
+		if funcs.IsE164(phone) {
+			t.Fatalf("expected %s to be invalid", phone)
+		}
+	}
+}
+
+func TestIsValidIranianCompanyID(t *testing.T) {
+	for _, id := range []string{"10380284790", "14007650912"} {
+		if !funcs.IsValidIranianCompanyID(id) {
+			t.Fatalf("expected %s to be valid", id)
+		}
+	}
+
+	for _, id := range []string{"10380284791", "1038028479", "103802847900", "1038028479a", "10300000000"} {
+		if funcs.IsValidIranianCompanyID(id) {
+			t.Fatalf("expected %s to be invalid", id)
+		}
+	}
+}
+
+func TestIsValidIranianPlate(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		if !funcs.IsValidIranianPlate("12ب345-67") {
+			t.Fatal("expected plate to be valid")
+		}
+		if !funcs.IsValidIranianPlate("45الف678-11") {
+			t.Fatal("expected government plate to be valid")
+		}
+	})
+
+	t.Run("BadLetter", func(t *testing.T) {
+		if funcs.IsValidIranianPlate("12ح345-67") {
+			t.Fatal("expected invalid letter to be rejected")
+		}
+	})
+
+	t.Run("BadProvince", func(t *testing.T) {
+		if funcs.IsValidIranianPlate("12ب345-05") {
+			t.Fatal("expected out of range province code to be rejected")
+		}
+	})
+}
+
+func TestIsValidIranianIBANStrict(t *testing.T) {
+	t.Run("KnownBank", func(t *testing.T) {
+		if !funcs.IsValidIranianIBAN("IR820540102680020817909002") {
+			t.Fatal("expected IBAN to pass lenient check")
+		}
+		if !funcs.IsValidIranianIBANStrict("IR820540102680020817909002") {
+			t.Fatal("expected IBAN to pass strict check")
+		}
+		if bank, ok := funcs.IranianIBANBank("820540102680020817909002"); !ok || bank != "Parsian" {
+			t.Fatalf("expected Parsian, got %q", bank)
+		}
+	})
+
+	t.Run("UnknownBank", func(t *testing.T) {
+		if !funcs.IsValidIranianIBAN("IR062960000000100324200001") {
+			t.Fatal("expected IBAN to pass lenient check")
+		}
+		if funcs.IsValidIranianIBANStrict("IR062960000000100324200001") {
+			t.Fatal("expected unknown bank code to fail strict check")
+		}
+	})
+
+	t.Run("InvalidChecksum", func(t *testing.T) {
+		if funcs.IsValidIranianIBAN("IR820540102680020817909003") {
+			t.Fatal("expected invalid checksum to fail")
+		}
+	})
+}
+
+func TestIsValidIBAN(t *testing.T) {
+	for _, iban := range []string{
+		"DE89370400440532013000",
+		"FR1420041010050500013M02606",
+		"fr14 2004 1010 0505 0001 3m02 606",
+		"GB82WEST12345698765432",
+		"IR820540102680020817909002",
+	} {
+		if !funcs.IsValidIBAN(iban) {
+			t.Fatalf("expected %s to be valid", iban)
+		}
+	}
+
+	for _, iban := range []string{
+		"DE8937040044053201300",
+		"DE89370400440532013001",
+		"FR1420041010050500013M0260",
+		"ZZ89370400440532013000",
+		"DE89-3704-0044-0532-0130-00",
+	} {
+		if funcs.IsValidIBAN(iban) {
+			t.Fatalf("expected %s to be invalid", iban)
+		}
+	}
+}
+
+func TestNormalizeIranianIBAN(t *testing.T) {
+	expected := "IR820540102680020817909002"
+	inputs := []string{
+		"IR820540102680020817909002",
+		"ir82 0540 1026 8002 0817 9090 02",
+		"820540102680020817909002",
+		" 8205 4010 2680 0208 1790 9002 ",
+	}
+
+	for _, input := range inputs {
+		if iban, ok := funcs.NormalizeIranianIBAN(input); !ok || iban != expected {
+			t.Fatalf("expected %s for %q, got %q", expected, input, iban)
+		}
+	}
+
+	if iban, ok := funcs.NormalizeIranianIBAN("IR82 0540 1026 8002 0817 9090 03"); ok || iban != "" {
+		t.Fatalf("expected invalid IBAN to fail, got %q", iban)
+	}
+}
+
+func TestFormatIranianIBAN(t *testing.T) {
+	expected := "IR82 0540 1026 8002 0817 9090 02"
+	for _, input := range []string{"IR820540102680020817909002", "820540102680020817909002", expected} {
+		if iban, ok := funcs.FormatIranianIBAN(input); !ok || iban != expected {
+			t.Fatalf("expected %s for %q, got %q", expected, input, iban)
+		}
+	}
+
+	if iban, ok := funcs.FormatIranianIBAN("IR820540102680020817909003"); ok || iban != "" {
+		t.Fatalf("expected invalid IBAN to fail, got %q", iban)
+	}
+}
+
+func TestValidateIranianBankCard(t *testing.T) {
+	if err := funcs.ValidateIranianBankCard("6037997599999993"); err != nil {
+		t.Fatalf("expected valid card, got %v", err)
+	}
+	if !funcs.IsValidIranianBankCard("6037997599999993") {
+		t.Fatal("expected valid card")
+	}
+
+	if err := funcs.ValidateIranianBankCard("603799759999999"); !errors.Is(err, funcs.ErrCardLength) {
+		t.Fatalf("expected length error, got %v", err)
+	}
+	if err := funcs.ValidateIranianBankCard("60379975999999a5"); !errors.Is(err, funcs.ErrCardFormat) {
+		t.Fatalf("expected format error, got %v", err)
+	}
+	if err := funcs.ValidateIranianBankCard("6037997599999996"); !errors.Is(err, funcs.ErrCardChecksum) {
+		t.Fatalf("expected checksum error, got %v", err)
+	}
+}
+
+func TestIsJSONNumber(t *testing.T) {
+	for _, s := range []string{"0", "-12", "3.14", "1e10", "-2.5E-3", "6.02e+23"} {
+		if !funcs.IsJSONNumber(s) {
+			t.Fatalf("expected %q to be valid", s)
+		}
+	}
+
+	for _, s := range []string{"", "01", "-01.5", "1.", ".5", "+1", "Infinity", "NaN", "1e", "0x10"} {
+		if funcs.IsJSONNumber(s) {
+			t.Fatalf("expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestIsJSONBool(t *testing.T) {
+	if !funcs.IsJSONBool("true") || !funcs.IsJSONBool("false") {
+		t.Fatal("expected true and false to be valid")
+	}
+
+	for _, s := range []string{"", "True", "1", "yes"} {
+		if funcs.IsJSONBool(s) {
+			t.Fatalf("expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestIsValidJSON(t *testing.T) {
+	t.Run("Object", func(t *testing.T) {
+		if !funcs.IsValidJSON(`{"name": "john", "tags": [1, 2]}`) {
+			t.Fatal("expected object to be valid")
+		}
+	})
+
+	t.Run("Array", func(t *testing.T) {
+		if !funcs.IsValidJSON(`[{"id": 1}, {"id": 2}]`) {
+			t.Fatal("expected array to be valid")
+		}
+	})
+
+	t.Run("TrailingGarbage", func(t *testing.T) {
+		if funcs.IsValidJSON(`{"name": "john"} extra`) {
+			t.Fatal("expected trailing garbage to be rejected")
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if funcs.IsValidJSON("") || funcs.IsValidJSON("   ") {
+			t.Fatal("expected empty input to be rejected")
+		}
+	})
+}
+
+func TestIsBase64(t *testing.T) {
+	t.Run("StandardPadded", func(t *testing.T) {
+		if !funcs.IsBase64("aGVsbG8gd29ybGQ=", false) {
+			t.Fatal("expected padded standard base64 to be valid")
+		}
+		if funcs.IsBase64("aGVsbG8gd29ybGQ", false) {
+			t.Fatal("expected unpadded standard base64 to be rejected")
+		}
+	})
+
+	t.Run("URLSafe", func(t *testing.T) {
+		if !funcs.IsBase64("-_-_aGk", true) {
+			t.Fatal("expected unpadded URL-safe base64 to be valid")
+		}
+		if funcs.IsBase64("-_-_aGk=", false) {
+			t.Fatal("expected URL-safe characters to be rejected by standard variant")
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		for _, s := range []string{"", "abc$def", "a===", "aGVsbG8@"} {
+			if funcs.IsBase64(s, false) || funcs.IsBase64(s, true) {
+				t.Fatalf("expected %q to be rejected", s)
+			}
+		}
+	})
+}
+
+func TestIsUUID(t *testing.T) {
+	t.Run("V4", func(t *testing.T) {
+		if !funcs.IsUUID("3f2504e0-4f89-41d3-9a0c-0305e82c3301", 4) {
+			t.Fatal("expected v4 UUID to be valid")
+		}
+		if !funcs.IsUUID("3F2504E0-4F89-41D3-9A0C-0305E82C3301", 0) {
+			t.Fatal("expected uppercase UUID to be valid for any version")
+		}
+	})
+
+	t.Run("WrongVersion", func(t *testing.T) {
+		if funcs.IsUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8", 4) {
+			t.Fatal("expected v1 UUID to be rejected when v4 required")
+		}
+		if !funcs.IsUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8", 1) {
+			t.Fatal("expected v1 UUID to be valid when v1 required")
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		for _, s := range []string{"", "3f2504e0-4f89-41d3-9a0c", "3f2504e04f8941d39a0c0305e82c3301", "zf2504e0-4f89-41d3-9a0c-0305e82c3301"} {
+			if funcs.IsUUID(s, 0) {
+				t.Fatalf("expected %q to be rejected", s)
+			}
+		}
+	})
+}
+
+func TestLuhnValid(t *testing.T) {
+	t.Run("IMEI", func(t *testing.T) {
+		if !funcs.LuhnValid("490154203237518") {
+			t.Fatal("expected IMEI to be valid")
+		}
+		if funcs.LuhnValid("490154203237519") {
+			t.Fatal("expected invalid IMEI to be rejected")
+		}
+	})
+
+	t.Run("Card", func(t *testing.T) {
+		if !funcs.LuhnValid("6037997599999993") || !funcs.IsValidIranianBankCard("6037997599999993") {
+			t.Fatal("expected card number to be valid")
+		}
+		if funcs.IsValidIranianBankCard("490154203237518") {
+			t.Fatal("expected 15 digit number to be rejected as bank card")
+		}
+	})
+
+	t.Run("NonDigits", func(t *testing.T) {
+		if funcs.LuhnValid("") || funcs.LuhnValid("4901-5420") {
+			t.Fatal("expected non digit input to be rejected")
+		}
+	})
+}
+
+func TestISBN(t *testing.T) {
+	t.Run("ISBN10", func(t *testing.T) {
+		if !funcs.IsISBN10("0-8044-2957-X") || !funcs.IsISBN10("0306406152") {
+			t.Fatal("expected ISBN-10 to be valid")
+		}
+		if funcs.IsISBN10("0306406153") {
+			t.Fatal("expected ISBN-10 checksum failure")
+		}
+	})
+
+	t.Run("ISBN13", func(t *testing.T) {
+		if !funcs.IsISBN13("978-0-306-40615-7") {
+			t.Fatal("expected ISBN-13 to be valid")
+		}
+		if funcs.IsISBN13("978-0-306-40615-8") {
+			t.Fatal("expected ISBN-13 checksum failure")
+		}
+	})
+}
+
+func TestIsHexColor(t *testing.T) {
+	for _, s := range []string{"#fff", "#FFFA", "#1a2b3c", "#1A2B3C80"} {
+		if !funcs.IsHexColor(s) {
+			t.Fatalf("expected %q to be valid", s)
+		}
+	}
+
+	for _, s := range []string{"", "fff", "#ff", "#fffff", "#ggg", "#1a2b3c8"} {
+		if funcs.IsHexColor(s) {
+			t.Fatalf("expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestIsSemVer(t *testing.T) {
+	for _, s := range []string{"1.2.3", "0.0.0", "1.0.0-alpha.1+build.5", "1.0.0-0.3.7", "1.0.0+20130313144700"} {
+		if !funcs.IsSemVer(s) {
+			t.Fatalf("expected %q to be valid", s)
+		}
+	}
+
+	for _, s := range []string{"", "1.2", "1.01.0", "01.1.0", "1.0.0-", "1.0.0-alpha..1", "1.0.0-01", "1.0.0+", "v1.2.3"} {
+		if funcs.IsSemVer(s) {
+			t.Fatalf("expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestIsSlug(t *testing.T) {
+	for _, s := range []string{"my-post-1", "post", "2024-recap"} {
+		if !funcs.IsSlug(s) {
+			t.Fatalf("expected %q to be valid", s)
+		}
+	}
+
+	for _, s := range []string{"", "-bad-", "bad--slug", "Bad Slug", "Bad-slug", "my_post"} {
+		if funcs.IsSlug(s) {
+			t.Fatalf("expected %q to be invalid", s)
+		}
+	}
+}
+
+func TestIsDomain(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		for _, s := range []string{"example.com", "sub.example.co.uk", "localhost", "my-site.org"} {
+			if !funcs.IsDomain(s) {
+				t.Fatalf("expected %q to be valid", s)
+			}
+		}
+	})
+
+	t.Run("Punycode", func(t *testing.T) {
+		if !funcs.IsDomain("xn--mgbh0fb.xn--mgba3a4f16a") {
+			t.Fatal("expected punycode domain to be valid")
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		long := strings.Repeat("a", 64) + ".com"
+		for _, s := range []string{"", long, "-bad.com", "bad-.com", "exa mple.com", "example..com", "ex_ample.com"} {
+			if funcs.IsDomain(s) {
+				t.Fatalf("expected %q to be invalid", s)
+			}
+		}
+	})
+}
+
+func TestIsLatitudeAndLongitude(t *testing.T) {
+	for _, lat := range []string{"90.0", "-90", "0", "35.6892"} {
+		if !funcs.IsLatitude(lat) {
+			t.Fatalf("expected latitude %s to be valid", lat)
+		}
+	}
+	for _, lat := range []string{"90.0001", "-90.0001", "abc", "", "NaN"} {
+		if funcs.IsLatitude(lat) {
+			t.Fatalf("expected latitude %s to be invalid", lat)
+		}
+	}
+
+	for _, lng := range []string{"180.0", "-180", "51.3890"} {
+		if !funcs.IsLongitude(lng) {
+			t.Fatalf("expected longitude %s to be valid", lng)
+		}
+	}
+	for _, lng := range []string{"180.0001", "-180.0001", "12,5", "Inf"} {
+		if funcs.IsLongitude(lng) {
+			t.Fatalf("expected longitude %s to be invalid", lng)
+		}
+	}
+}
+
+func TestIsPercentage(t *testing.T) {
+	for _, value := range []string{"100%", "0", "50", "12.5%", "0%"} {
+		if !funcs.IsPercentage(value, true) {
+			t.Fatalf("expected %s to be valid", value)
+		}
+	}
+	for _, value := range []string{"101", "100.01%", "-1", "abc", "%", "", "50%%", "1e2"} {
+		if funcs.IsPercentage(value, true) {
+			t.Fatalf("expected %s to be invalid", value)
+		}
+	}
+
+	if funcs.IsPercentage("100%", false) {
+		t.Fatal("expected percent sign to be rejected when symbol is not allowed")
+	} else if !funcs.IsPercentage("100", false) {
+		t.Fatal("expected 100 to be valid without symbol")
+	}
+}
+
+func TestIsTimeOfDay(t *testing.T) {
+	for _, s := range []string{"00:00", "09:30", "23:59"} {
+		if !funcs.IsTimeOfDay(s, false) {
+			t.Fatalf("expected %s to be valid", s)
+		}
+	}
+	for _, s := range []string{"24:00", "23:60", "9:30", "09:30:00", "0930", ""} {
+		if funcs.IsTimeOfDay(s, false) {
+			t.Fatalf("expected %s to be invalid", s)
+		}
+	}
+
+	for _, s := range []string{"09:30", "23:59:59", "00:00:00"} {
+		if !funcs.IsTimeOfDay(s, true) {
+			t.Fatalf("expected %s to be valid with seconds", s)
+		}
+	}
+	for _, s := range []string{"23:59:60", "24:00:00", "09:30:5"} {
+		if funcs.IsTimeOfDay(s, true) {
+			t.Fatalf("expected %s to be invalid with seconds", s)
+		}
+	}
+}
+
+func TestIsDuration(t *testing.T) {
+	for _, s := range []string{"500ms", "30s", "1h30m", "-1.5h", "0s"} {
+		if !funcs.IsDuration(s) {
+			t.Fatalf("expected %s to be valid", s)
+		}
+	}
+
+	for _, s := range []string{"", "0", "30", "1.5", "1hour", "h1"} {
+		if funcs.IsDuration(s) {
+			t.Fatalf("expected %s to be invalid", s)
+		}
+	}
+}
+
+func TestIsCardExpiry(t *testing.T) {
+	now := time.Now()
+	for _, s := range []string{
+		now.AddDate(1, 0, 0).Format("01/06"),
+		now.AddDate(1, 0, 0).Format("01/2006"),
+		now.Format("01/06"),
+	} {
+		if !funcs.IsCardExpiry(s) {
+			t.Fatalf("expected %s to be valid", s)
+		}
+	}
+
+	past := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+	for _, s := range []string{past.Format("01/06"), past.Format("01/2006"), "13/30", "00/30", "1/30", "01-30", "01/130"} {
+		if funcs.IsCardExpiry(s) {
+			t.Fatalf("expected %s to be invalid", s)
+		}
+	}
+}
+
+func TestIsValidIPVersion(t *testing.T) {
+	cases := []struct {
+		ip      string
+		version int
+		valid   bool
+	}{
+		{"192.168.1.1", 0, true},
+		{"2001:db8::1", 0, true},
+		{"192.168.1.1", 4, true},
+		{"2001:db8::1", 4, false},
+		{"::ffff:192.168.1.1", 4, false},
+		{"2001:db8::1", 6, true},
+		{"192.168.1.1", 6, false},
+		{"192.168.1.1", 5, false},
+		{"300.1.1.1", 0, false},
+	}
+	for _, c := range cases {
+		if funcs.IsValidIPVersion(c.ip, c.version) != c.valid {
+			t.Fatalf("expected %s with version %d valid=%v", c.ip, c.version, c.valid)
+		}
+	}
+}
+
+func TestIsNotCommonPassword(t *testing.T) {
+	blocklist := map[string]struct{}{"123456": {}, "password": {}}
+
+	for _, s := range []string{"123456", " Password ", "PASSWORD"} {
+		if funcs.IsNotCommonPassword(s, blocklist) {
+			t.Fatalf("expected %q to be blocked", s)
+		}
+	}
+
+	if !funcs.IsNotCommonPassword("c0rrect-h0rse-battery", blocklist) {
+		t.Fatal("expected uncommon password to be allowed")
+	}
+}
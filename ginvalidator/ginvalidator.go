@@ -0,0 +1,67 @@
+// Package ginvalidator provides a gin binding.StructValidator adapter for govalidator.
+//
+// Register it as gin binding validator:
+//
+//	binding.Validator = ginvalidator.New(validator.New(), "en", options...)
+//
+// Struct rules are read from the validate tag (go-playground default), not the gin binding tag.
+package ginvalidator
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mekramy/govalidator"
+)
+
+// Validator implements the gin binding.StructValidator interface.
+type Validator struct {
+	engine    *validator.Validate
+	validator govalidator.Validator
+	locale    string
+}
+
+// New creates a new gin adapter using the given validator engine and locale for error messages.
+// Options are passed to govalidator.NewValidator.
+func New(engine *validator.Validate, locale string, options ...govalidator.Options) *Validator {
+	return &Validator{
+		engine:    engine,
+		validator: govalidator.NewValidator(engine, options...),
+		locale:    locale,
+	}
+}
+
+// ValidateStruct validates structs or pointers to struct and returns govalidator.ValidationError on failure.
+// Other kinds are ignored.
+func (v *Validator) ValidateStruct(obj any) error {
+	if obj == nil {
+		return nil
+	}
+
+	val := reflect.ValueOf(obj)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if err := v.validator.Struct(v.locale, obj); err.HasError() {
+		return err
+	}
+	return nil
+}
+
+// Engine returns the underlying *validator.Validate.
+func (v *Validator) Engine() any {
+	return v.engine
+}
+
+// Validator returns the wrapped govalidator.Validator for registering custom rules and translations.
+func (v *Validator) Validator() govalidator.Validator {
+	return v.validator
+}
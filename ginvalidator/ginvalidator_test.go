@@ -0,0 +1,61 @@
+package ginvalidator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mekramy/goi18n"
+	"github.com/mekramy/govalidator"
+	"github.com/mekramy/govalidator/ginvalidator"
+	"golang.org/x/text/language"
+)
+
+func TestValidateStruct(t *testing.T) {
+	// structValidator mirrors the gin binding.StructValidator interface
+	type structValidator interface {
+		ValidateStruct(any) error
+		Engine() any
+	}
+
+	type User struct {
+		Name  string `validate:"required"`
+		Email string `validate:"required,email"`
+	}
+
+	engine := validator.New()
+	var sv structValidator = ginvalidator.New(
+		engine, "en",
+		govalidator.WithTranslator(goi18n.NewTranslator("en", language.English), ""),
+	)
+
+	t.Run("Engine", func(t *testing.T) {
+		if sv.Engine() != engine {
+			t.Fatal("expected underlying validator engine")
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if err := sv.ValidateStruct(&User{Name: "John", Email: "john@example.com"}); err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		err := sv.ValidateStruct(&User{Email: "invalid"})
+		var verr govalidator.ValidationError
+		if err == nil {
+			t.Fatal("expected validation error")
+		} else if !errors.As(err, &verr) {
+			t.Fatal("expected ValidationError")
+		} else if !verr.IsFailedOn("Name", "required") || !verr.IsFailedOn("Email", "email") {
+			t.Fatalf("unexpected errors: %v", verr.Rules())
+		}
+	})
+
+	t.Run("NonStruct", func(t *testing.T) {
+		if err := sv.ValidateStruct([]string{"a"}); err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+	})
+}
@@ -1,9 +1,15 @@
 package govalidator
 
 import (
+	"context"
+	"fmt"
 	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mekramy/goi18n"
@@ -23,36 +29,212 @@ func WithTranslator(translator goi18n.Translator, prefix string) Options {
 	}
 }
 
+// ErrorKeyMode defines how struct validation errors are keyed.
+type ErrorKeyMode int
+
+const (
+	// KeyFieldName keys errors by the field name (e.g. City).
+	// Keys are short and match flat forms, but nested fields sharing a name overwrite each other.
+	// Embedded struct fields shadowed by an outer field are keyed by their embedded path (e.g. Audit.CreatedBy).
+	KeyFieldName ErrorKeyMode = iota
+
+	// KeyNamespace keys errors by the field namespace relative to the root struct (e.g. Address.City, Items[0].Name).
+	// Keys are unique for nested structs and slices, but clients must know the structure path.
+	KeyNamespace
+)
+
+// WithErrorKeyMode configures how struct validation errors are keyed, defaults to KeyFieldName.
+func WithErrorKeyMode(mode ErrorKeyMode) Options {
+	return func(iv *I18nValidator) {
+		iv.keyMode = mode
+	}
+}
+
+// WithMessageTag configures the struct tag used for inline rule messages, defaults to msg.
+// Entries are separated by semicolon in rule=message form (e.g. msg:"required=Name is mandatory;min=Too short")
+// and take precedence over translations. Pass empty tag to disable inline messages.
+func WithMessageTag(tag string) Options {
+	return func(iv *I18nValidator) {
+		iv.messageTag = strings.TrimSpace(tag)
+	}
+}
+
+// WithBailOnFirstError limits struct validation errors to the first failing field in struct declaration order.
+// All errors of that field are kept. The underlying validator still validates the whole struct,
+// the result is truncated after validation.
+func WithBailOnFirstError() Options {
+	return func(iv *I18nValidator) {
+		iv.bail = true
+	}
+}
+
+// WithParamExtractor registers a parameter extractor for the rule.
+// Values returned by fn are merged into the message placeholders (e.g. {min} and {max} parsed from a JSON parameter)
+// and take precedence over the default placeholders.
+func WithParamExtractor(rule string, fn func(param string) map[string]any) Options {
+	rule = strings.TrimSpace(rule)
+	return func(iv *I18nValidator) {
+		if rule == "" || fn == nil {
+			return
+		}
+		iv.extractors[rule] = func(param string, _ any) map[string]any {
+			return fn(param)
+		}
+	}
+}
+
+// WithObserver registers a callback invoked after each validation with its kind, elapsed time and error count.
+// Kind is the validated type name (e.g. main.User) for struct and map validations and "var" for variable validations.
+// The callback runs synchronously on the validating goroutine and must be safe for concurrent use.
+func WithObserver(fn func(kind string, d time.Duration, errCount int)) Options {
+	return func(iv *I18nValidator) {
+		iv.observer = fn
+	}
+}
+
+// WithNumberFormatter registers a function that formats numeric rule parameters per locale before
+// interpolation into {param} (e.g. Persian digits with grouping for min=1000). The number is passed as int64
+// or float64. Plural form is still selected by the raw number. Without formatter numbers are rendered as is.
+func WithNumberFormatter(fn func(locale string, n any) string) Options {
+	return func(iv *I18nValidator) {
+		iv.numberFormatter = fn
+	}
+}
+
+// WithInternalErrorTranslator registers a function mapping internal errors (e.g. ErrNotAStruct or errors returned
+// by AddValidationWithError validations) to user-safe localized messages returned by ValidationError.InternalMessage.
+// Returning empty string falls back to the internal error message.
+func WithInternalErrorTranslator(fn func(locale string, err error) string) Options {
+	return func(iv *I18nValidator) {
+		iv.internalTranslator = fn
+	}
+}
+
+// WithInterfaceTranslation enables or disables the ValueTranslatable, Translatable and TranslatableField lookup
+// on validated values, enabled by default. Disabling it skips the per-error type assertions and resolves messages
+// directly through the translator.
+func WithInterfaceTranslation(enabled bool) Options {
+	return func(iv *I18nValidator) {
+		iv.skipInterfaces = !enabled
+	}
+}
+
+// WithTrimSpace trims leading and trailing spaces of string fields before running custom validations
+// registered through AddValidation, AddValidationWithError and the validator options. The underlying struct is not mutated.
+// Built-in go-playground rules (e.g. required, email, min) can't be intercepted and still see the raw value.
+func WithTrimSpace() Options {
+	return func(iv *I18nValidator) {
+		iv.trimSpace = true
+	}
+}
+
+// WithTagResolver returns an option for configuring the I18nValidator to resolve field names from the given tags.
+// Tags are checked in the given priority order and duplicates are ignored. If no valid tag is found, it defaults to the field name.
+// Fields with the "-" tag are ignored. Passing no tags is a no-op.
+func WithTagResolver(tags ...string) Options {
+	// Normalize and deduplicate tags preserving priority
+	resolved := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" && !slices.Contains(resolved, tag) {
+			resolved = append(resolved, tag)
+		}
+	}
+
+	return func(iv *I18nValidator) {
+		if len(resolved) == 0 {
+			return
+		}
+
+		// Register a function that resolves field names from tags
+		iv.register(func(iv *I18nValidator, engine *validator.Validate) {
+			iv.tagNameFunc = func(field reflect.StructField) string {
+				var name string
+
+				// Check the tags in order
+				for _, tag := range resolved {
+					if n := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]; n != "" {
+						name = n
+						break
+					}
+				}
+
+				// Ignore fields with "-" tag
+				if name == "-" {
+					return ""
+				}
+
+				// Return the resolved name or default to field name
+				if name != "" {
+					return name
+				}
+				return field.Name
+			}
+			engine.RegisterTagNameFunc(iv.tagNameFunc)
+		})
+	}
+}
+
 // WithFiberTagResolver returns an option for configuring the I18nValidator to resolve field names from various tags.
 // It checks the "field", "json", "form", and "xml" tags in priority order. If no valid tag is found, it defaults to the field name.
 // Fields with the "-" tag are ignored.
 func WithFiberTagResolver() Options {
+	return WithTagResolver("field", "json", "form", "xml")
+}
+
+// WithJSONTagResolver returns an option for configuring the I18nValidator to resolve field names from the "json" tag only.
+// Fields with the "-" tag are ignored. If no json tag is found, it defaults to the field name.
+func WithJSONTagResolver() Options {
+	return WithTagResolver("json")
+}
+
+// WithAlias registers a rule alias (e.g. iranian_contact=mobile|phone) with localized messages.
+// Alias message is used when any of the underlying rules fails under the alias.
+func WithAlias(alias, rules string, messages map[string]string) Options {
+	alias = strings.TrimSpace(alias)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid value",
+	)
+
 	return func(iv *I18nValidator) {
-		// Register a function that resolves field names from tags
-		iv.validator.RegisterTagNameFunc(func(field reflect.StructField) string {
-			var name string
+		if alias == "" {
+			return
+		}
 
-			// Check the "field", "json", "form", and "xml" tags in order
-			if n := strings.SplitN(field.Tag.Get("field"), ",", 2)[0]; n != "" {
-				name = n
-			} else if n := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]; n != "" {
-				name = n
-			} else if n := strings.SplitN(field.Tag.Get("form"), ",", 2)[0]; n != "" {
-				name = n
-			} else if n := strings.SplitN(field.Tag.Get("xml"), ",", 2)[0]; n != "" {
-				name = n
-			}
+		iv.register(func(_ *I18nValidator, engine *validator.Validate) {
+			engine.RegisterAlias(alias, rules)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, alias, m)
+		}
+	}
+}
 
-			// Ignore fields with "-" tag
-			if name == "-" {
-				return ""
+// WithStructValidation registers a struct level validation function for the given type.
+// Messages are keyed by the rule reported by fn and then by locale (e.g. {"email_or_phone": {"en": "..."}}).
+func WithStructValidation(fn validator.StructLevelFunc, typ any, messages map[string]map[string]string) Options {
+	return func(iv *I18nValidator) {
+		iv.register(func(_ *I18nValidator, engine *validator.Validate) {
+			engine.RegisterStructValidation(fn, typ)
+		})
+		for rule, translations := range messages {
+			for l, m := range translations {
+				iv.AddTranslation(l, rule, m)
 			}
+		}
+	}
+}
 
-			// Return the resolved name or default to field name
-			if name != "" {
-				return name
-			}
-			return field.Name
+// WithCustomTypeFunc registers a function extracting the comparable value from custom types (e.g. sql.NullString)
+// before validation, so rules like required see the underlying value instead of the wrapper struct.
+func WithCustomTypeFunc(fn validator.CustomTypeFunc, types ...any) Options {
+	return func(iv *I18nValidator) {
+		if fn == nil || len(types) == 0 {
+			return
+		}
+
+		iv.register(func(_ *I18nValidator, engine *validator.Validate) {
+			engine.RegisterCustomTypeFunc(fn, types...)
 		})
 	}
 }
@@ -152,6 +334,43 @@ func WithIranianMobileValidator(messages map[string]string, rule ...string) Opti
 	}
 }
 
+// WithNormalizedMobileValidator adds validation for Iranian mobile numbers in 09xxxxxxxxx, +989xxxxxxxxx or 00989xxxxxxxxx form.
+func WithNormalizedMobileValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("normalized_mobile", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid iranian mobile number",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			_, ok := funcs.NormalizeIranianMobile(fl.Field().String())
+			return ok
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithE164Validator adds validation for international phone numbers in E.164 format (e.g. +14155552671).
+func WithE164Validator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("e164", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid E.164 phone number",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsE164(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
 // WithIranianPostalCodeValidator adds validation for 10-digit Iranian postal codes.
 func WithIranianPostalCodeValidator(messages map[string]string, rule ...string) Options {
 	tag := resolveParams("postal_code", rule...)
@@ -206,6 +425,43 @@ func WithIranianNationalCodeValidator(messages map[string]string, rule ...string
 	}
 }
 
+// WithPaddedNationalCodeValidator adds validation for Iranian national codes, accepting codes stored without leading zeros.
+func WithPaddedNationalCodeValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("padded_national_code", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid iranian national code",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			_, ok := funcs.NormalizeIranianNationalCode(fl.Field().String())
+			return ok
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithIranianCompanyIDValidator adds validation for 11-digit Iranian legal entity national IDs.
+func WithIranianCompanyIDValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("company_id", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid 11 digit iranian company national id",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsValidIranianCompanyID(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
 // WithIranianCreditNumberValidator adds validation for 16-digit Iranian credit card numbers.
 func WithIranianCreditNumberValidator(messages map[string]string, rule ...string) Options {
 	tag := resolveParams("credit_number", rule...)
@@ -224,7 +480,26 @@ func WithIranianCreditNumberValidator(messages map[string]string, rule ...string
 	}
 }
 
+// WithLuhnValidator adds validation for digit strings using the Luhn checksum (e.g. card numbers, IMEI).
+func WithLuhnValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("luhn", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid number",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.LuhnValid(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
 // WithIranianIBANValidator adds validation for 24-digit Iranian IBAN numbers.
+// Use "strict" rule parameter (e.g. iban=strict) to also require a known bank code.
 func WithIranianIBANValidator(messages map[string]string, rule ...string) Options {
 	tag := resolveParams("iban", rule...)
 	messages = resolveMessages(
@@ -234,6 +509,9 @@ func WithIranianIBANValidator(messages map[string]string, rule ...string) Option
 
 	return func(iv *I18nValidator) {
 		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			if fl.Param() == "strict" {
+				return funcs.IsValidIranianIBANStrict(fl.Field().String())
+			}
 			return funcs.IsValidIranianIBAN(fl.Field().String())
 		})
 		for l, m := range messages {
@@ -242,7 +520,46 @@ func WithIranianIBANValidator(messages map[string]string, rule ...string) Option
 	}
 }
 
+// WithIBANValidator adds validation for international IBANs using the generic ISO 13616 MOD-97 check.
+func WithIBANValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("iban_intl", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid IBAN",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsValidIBAN(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithIranianPlateValidator adds validation for normalized iranian vehicle license plates (e.g. 12ب345-67).
+func WithIranianPlateValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("plate", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid iranian license plate",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsValidIranianPlate(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
 // WithJalaaliValidator adds validation for Jalaali datetime strings.
+// Rule parameter is the layout (e.g. jalaali=2006-01-02), defaults to RFC3339.
+// Parsed dates must round-trip to the same string, so out of range and zero components are rejected.
+// Zero offset may be written as Z or numeric offset (e.g. +00:00) for Z07 layout zones.
 func WithJalaaliValidator(messages map[string]string, rule ...string) Options {
 	tag := resolveParams("jalaali", rule...)
 	messages = resolveMessages(
@@ -256,8 +573,1030 @@ func WithJalaaliValidator(messages map[string]string, rule ...string) Options {
 			if layout == "" {
 				layout = time.RFC3339
 			}
-			d, err := gojalaali.Parse(layout, fl.Field().String())
-			return err == nil && !d.IsZero()
+			value := fl.Field().String()
+			d, err := gojalaali.Parse(layout, value)
+			if err != nil || d.IsZero() {
+				return false
+			}
+
+			// Compare with both Z and numeric rendering of zero offset
+			return d.Format(layout) == value ||
+				d.Format(strings.ReplaceAll(layout, "Z07", "-07")) == value
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithJSONValidator adds validation for well-formed JSON strings.
+func WithJSONValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("json", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid JSON",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsValidJSON(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithJSONPatchValidator adds validation for RFC 6902 JSON Patch documents.
+func WithJSONPatchValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("json_patch", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid JSON patch document",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsValidJSONPatch(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithURLValidator adds validation for absolute URLs.
+// Allowed schemes can be passed as space separated rule parameter (e.g. url_scheme=https http).
+func WithURLValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("url_scheme", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid URL",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsValidURL(
+				fl.Field().String(),
+				strings.Fields(fl.Param())...,
+			)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithMalwareHashValidator adds validation for uploaded files against a known-malware hash denylist.
+// File checksum is computed using algo and passed to isMalicious, errors returned by checker are reported as internal error.
+func WithMalwareHashValidator(algo string, isMalicious func(hash string) (bool, error), messages map[string]string, rule ...string) Options {
+	tag := resolveParams("malware_hash", rule...)
+	messages = resolveMessages(
+		messages,
+		"File is not allowed",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidationWithError(tag, func(fl validator.FieldLevel) (bool, error) {
+			file, ok := toFileHeader(fl.Field())
+			if !ok || isMalicious == nil {
+				return false, nil
+			}
+
+			hash, err := funcs.FileHash(file, algo)
+			if err != nil {
+				return false, err
+			}
+
+			malicious, err := isMalicious(hash)
+			if err != nil {
+				return false, err
+			}
+			return !malicious, nil
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithLatitudeValidator adds validation for latitude within [-90, 90] range.
+// Both numeric and string fields are supported.
+func WithLatitudeValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("latitude", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid latitude",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsLatitude(fmt.Sprint(fl.Field().Interface()))
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithLongitudeValidator adds validation for longitude within [-180, 180] range.
+// Both numeric and string fields are supported.
+func WithLongitudeValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("longitude", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid longitude",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsLongitude(fmt.Sprint(fl.Field().Interface()))
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithPercentageValidator adds validation for percentage within [0, 100] range (e.g. 50 or 12.5).
+// Trailing percent sign is accepted only with symbol rule parameter (e.g. percentage=symbol).
+func WithPercentageValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("percentage", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid percentage between 0 and 100",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsPercentage(fl.Field().String(), fl.Param() == "symbol")
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithGeoConsistencyValidator adds a struct level validation for checking submitted coordinates against postal code location.
+// lookup resolves the postal code coordinates, validation fails when distance exceeds maxKm or postal code is unknown.
+// Error is reported on postalField, named by the tag resolver, with "geo_consistency" rule for the given struct types.
+// Panics if no struct type is given.
+func WithGeoConsistencyValidator(
+	postalField, latField, lngField string,
+	lookup func(postal string) (lat, lng float64, ok bool),
+	maxKm float64,
+	messages map[string]string,
+	types ...any,
+) Options {
+	const tag = "geo_consistency"
+	if len(types) == 0 {
+		panic("govalidator: geo_consistency requires at least one struct type")
+	}
+	messages = resolveMessages(
+		messages,
+		"Location does not match the postal code",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.register(func(iv *I18nValidator, engine *validator.Validate) {
+			engine.RegisterStructValidation(func(sl validator.StructLevel) {
+				current := sl.Current()
+				postal, ok := structField(current, postalField)
+				if !ok {
+					return
+				}
+
+				// Resolve submitted and expected coordinates
+				lat, latOk := structField(current, latField)
+				lng, lngOk := structField(current, lngField)
+				valid := latOk && lngOk && lookup != nil
+				if valid {
+					submittedLat, latOk := toFloat(lat)
+					submittedLng, lngOk := toFloat(lng)
+					expectedLat, expectedLng, found := lookup(fmt.Sprint(postal.Interface()))
+					valid = latOk && lngOk && found &&
+						funcs.HaversineDistance(expectedLat, expectedLng, submittedLat, submittedLng) <= maxKm
+				}
+
+				if !valid {
+					sl.ReportError(postal.Interface(), iv.fieldName(current.Type(), postalField), postalField, tag, "")
+				}
+			}, types...)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithUUIDValidator adds validation for canonical UUID strings.
+// Rule parameter constrains the UUID version (e.g. uuid_v=4), empty means any version.
+func WithUUIDValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("uuid_v", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid UUID",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			version := 0
+			if fl.Param() != "" {
+				v, err := strconv.Atoi(fl.Param())
+				if err != nil {
+					return false
+				}
+				version = v
+			}
+			return funcs.IsUUID(fl.Field().String(), version)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithPrefixedUUIDValidator adds validation for prefixed UUID identifiers (e.g. prefixed_uuid=cus for cus_<uuid>).
+func WithPrefixedUUIDValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("prefixed_uuid", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid prefixed identifier",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsValidPrefixedUUID(fl.Field().String(), fl.Param())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithFuzzyEnumValidator adds validation for values within maxDistance edits of a vocabulary term.
+func WithFuzzyEnumValidator(tag string, vocab []string, maxDistance int, messages map[string]string) Options {
+	tag = resolveParams("fuzzy_enum", tag)
+	messages = resolveMessages(
+		messages,
+		"Must be one of the known values",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			_, ok := funcs.ClosestTerm(fl.Field().String(), vocab, maxDistance)
+			return ok
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithRegexValidator adds validation for string values matching the pattern.
+// The pattern is compiled once and panics if it is invalid.
+func WithRegexValidator(tag, pattern string, messages map[string]string) Options {
+	tag = resolveParams("regex", tag)
+	re := regexp.MustCompile(pattern)
+	messages = resolveMessages(
+		messages,
+		"Must match the required format",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return re.MatchString(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithFileSizeValidator adds validation for uploaded file size.
+// Rule parameter contains min and max size separated by space (e.g. filesize=1KB 5MB).
+// Invalid size parameters are reported as internal error.
+func WithFileSizeValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("filesize", rule...)
+	messages = resolveMessages(
+		messages,
+		"File size is not allowed",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidationWithError(tag, func(fl validator.FieldLevel) (bool, error) {
+			file, ok := toFileHeader(fl.Field())
+			if !ok {
+				return false, nil
+			}
+
+			params := splitParams(fl.Param())
+			if len(params) != 2 {
+				return false, fmt.Errorf("%s: expected min and max size, got %q", tag, fl.Param())
+			}
+			return funcs.IsValidFileSize(file, params[0], params[1])
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithFileTypeValidator adds validation for uploaded file MIME type.
+// Rule parameter contains allowed MIME types separated by space (e.g. filetype=image/png image/jpeg).
+// File read errors are reported as internal error.
+func WithFileTypeValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("filetype", rule...)
+	messages = resolveMessages(
+		messages,
+		"File type is not allowed",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidationWithError(tag, func(fl validator.FieldLevel) (bool, error) {
+			file, ok := toFileHeader(fl.Field())
+			if !ok {
+				return false, nil
+			}
+			return funcs.IsValidFileType(file, splitParams(fl.Param())...)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithSignByTypeValidator adds a struct level validation for checking amount sign against transaction type.
+// Amount must be positive for positiveTypes and negative for negativeTypes. Zero amounts and unknown types always fail.
+// Error is reported on amountField, named by the tag resolver, with "sign_by_type" rule for the given struct types.
+// Panics if no struct type is given.
+func WithSignByTypeValidator(
+	typeField, amountField string,
+	positiveTypes, negativeTypes []string,
+	messages map[string]string,
+	types ...any,
+) Options {
+	const tag = "sign_by_type"
+	if len(types) == 0 {
+		panic("govalidator: sign_by_type requires at least one struct type")
+	}
+	messages = resolveMessages(
+		messages,
+		"Amount sign does not match the transaction type",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.register(func(iv *I18nValidator, engine *validator.Validate) {
+			engine.RegisterStructValidation(func(sl validator.StructLevel) {
+				current := sl.Current()
+				amount, ok := structField(current, amountField)
+				if !ok {
+					return
+				}
+
+				// Resolve transaction type and amount
+				valid := false
+				if typ, ok := structField(current, typeField); ok {
+					if value, ok := toFloat(amount); ok {
+						kind := fmt.Sprint(typ.Interface())
+						switch {
+						case slices.Contains(positiveTypes, kind):
+							valid = value > 0
+						case slices.Contains(negativeTypes, kind):
+							valid = value < 0
+						}
+					}
+				}
+
+				if !valid {
+					sl.ReportError(amount.Interface(), iv.fieldName(current.Type(), amountField), amountField, tag, "")
+				}
+			}, types...)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithKnownLocaleValidator adds validation for locales registered on the validator through AddTranslation.
+// Supported locales are passed to the message as {locales} placeholder.
+func WithKnownLocaleValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("known_locale", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be one of the supported locales: {locales}",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.addValidation(tag, func(iv *I18nValidator, fl validator.FieldLevel) bool {
+			return iv.HasLocale(fl.Field().String())
+		})
+		iv.addExtractor(tag, func(iv *I18nValidator, _ string, _ any) map[string]any {
+			return map[string]any{"locales": strings.Join(iv.supportedLocales(), ", ")}
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithJSONNumberValidator adds validation for JSON number literal strings.
+func WithJSONNumberValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("json_number", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid number",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsJSONNumber(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithJSONBoolValidator adds validation for JSON boolean literal strings.
+func WithJSONBoolValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("json_bool", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be true or false",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsJSONBool(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithBase64Validator adds validation for base64 encoded strings.
+// Rule parameter selects the "std" (default) or "url" variant (e.g. base64=url).
+func WithBase64Validator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("base64", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid base64 string",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsBase64(fl.Field().String(), fl.Param() == "url")
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithISBNValidator adds validation for ISBN numbers.
+// Rule parameter selects "10", "13" or "any" (default) format (e.g. isbn=13).
+func WithISBNValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("isbn", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid ISBN",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			switch fl.Param() {
+			case "10":
+				return funcs.IsISBN10(fl.Field().String())
+			case "13":
+				return funcs.IsISBN13(fl.Field().String())
+			default:
+				return funcs.IsISBN10(fl.Field().String()) || funcs.IsISBN13(fl.Field().String())
+			}
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithHexColorValidator adds validation for hex color strings.
+// Use "optional" rule parameter (e.g. hexcolor=optional) to accept colors without the leading "#".
+func WithHexColorValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("hexcolor", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid hex color",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			color := fl.Field().String()
+			if fl.Param() == "optional" && !strings.HasPrefix(color, "#") {
+				color = "#" + color
+			}
+			return funcs.IsHexColor(color)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithSemVerValidator adds validation for semantic version strings.
+func WithSemVerValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("semver", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid semantic version",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsSemVer(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithSlugValidator adds validation for URL slugs.
+// Use "underscore" rule parameter (e.g. slug=underscore) to also accept single underscores as separator.
+func WithSlugValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("slug", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid slug",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			slug := fl.Field().String()
+			if fl.Param() == "underscore" {
+				slug = strings.ReplaceAll(slug, "_", "-")
+			}
+			return funcs.IsSlug(slug)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithDomainValidator adds validation for domain names.
+// Use "tld" rule parameter (e.g. domain=tld) to require a top level domain.
+func WithDomainValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("domain", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid domain name",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			domain := fl.Field().String()
+			if !funcs.IsDomain(domain) {
+				return false
+			}
+
+			// Top level domain must not be numeric
+			if fl.Param() == "tld" {
+				labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+				tld := labels[len(labels)-1]
+				return len(labels) > 1 && strings.Trim(tld, "0123456789") != ""
+			}
+			return true
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithMatchValidator adds validation for values equal to another field, similar to eqfield (e.g. match=Password).
+// The referenced field display name, resolved through TranslatableField, is passed to the message as {other}.
+func WithMatchValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("match", rule...)
+	messages = resolveMessages(
+		messages,
+		"{field} must match {other}",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			other, kind, _, ok := fl.GetStructFieldOK2()
+			if !ok || kind != fl.Field().Kind() {
+				return false
+			}
+			return reflect.DeepEqual(fl.Field().Interface(), other.Interface())
+		})
+		iv.fieldRefs[tag] = func(param string) string {
+			return param
+		}
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithEnumValidator adds validation for values within an allowed list, similar to oneof.
+// Allowed values are separated by space or comma (comma must be escaped as 0x2C in tags) and passed to the message as {allowed}.
+func WithEnumValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("enum", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be one of: {allowed}",
+	)
+
+	// parse splits allowed values by space or comma
+	parse := func(param string) []string {
+		return strings.FieldsFunc(param, func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})
+	}
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return slices.Contains(parse(fl.Param()), fmt.Sprint(fl.Field().Interface()))
+		})
+		iv.extractors[tag] = func(param string, _ any) map[string]any {
+			return map[string]any{"allowed": strings.Join(parse(param), ", ")}
+		}
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithTimeOfDayValidator adds validation for zero-padded 24-hour time of day strings in HH:MM form.
+// Use "seconds" rule parameter (e.g. time_of_day=seconds) to also accept HH:MM:SS form.
+func WithTimeOfDayValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("time_of_day", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid time of day",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsTimeOfDay(fl.Field().String(), fl.Param() == "seconds")
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithDurationValidator adds validation for go duration strings (e.g. 500ms, 1h30m).
+// Rule parameter can contain min and max duration separated by space (e.g. duration=1s 1h).
+// Invalid duration parameters are reported as internal error.
+func WithDurationValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("duration", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid duration",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidationWithError(tag, func(fl validator.FieldLevel) (bool, error) {
+			value := fl.Field().String()
+			if !funcs.IsDuration(value) {
+				return false, nil
+			}
+
+			params := splitParams(fl.Param())
+			if len(params) == 0 {
+				return true, nil
+			} else if len(params) != 2 {
+				return false, fmt.Errorf("%s: expected min and max duration, got %q", tag, fl.Param())
+			}
+
+			lower, err := time.ParseDuration(params[0])
+			if err != nil {
+				return false, fmt.Errorf("%s: invalid min duration: %w", tag, err)
+			}
+			upper, err := time.ParseDuration(params[1])
+			if err != nil {
+				return false, fmt.Errorf("%s: invalid max duration: %w", tag, err)
+			}
+
+			d, _ := time.ParseDuration(value)
+			return d >= lower && d <= upper, nil
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithCardExpiryValidator adds validation for card expiry dates in MM/YY or MM/YYYY form that are not in the past.
+func WithCardExpiryValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("card_expiry", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid card expiry date",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsCardExpiry(fl.Field().String())
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithIPValidator adds validation for IP addresses.
+// Use 4 or 6 rule parameter (e.g. ip=4) to require a specific version, empty parameter accepts either.
+func WithIPValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("ip", rule...)
+	messages = resolveMessages(
+		messages,
+		"Must be a valid IP address",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			version := 0
+			if fl.Param() != "" {
+				v, err := strconv.Atoi(fl.Param())
+				if err != nil {
+					return false
+				}
+				version = v
+			}
+			return funcs.IsValidIPVersion(fl.Field().String(), version)
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithListValidator adds validation for separated list strings with per-element rules.
+// Rule parameter is <sep>:<rules> (e.g. list=;:email), comma separator and rules must be escaped as 0x2C in tags
+// (e.g. list=0x2C:required0x2Cemail). Elements are trimmed before validation.
+// The first failing element and its zero-based index are passed to the message as {item} and {index}.
+// Invalid list parameters and internal errors of element rules are reported as internal error.
+func WithListValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("list", rule...)
+	messages = resolveMessages(
+		messages,
+		"{field} contains an invalid item: {item}",
+	)
+
+	return func(iv *I18nValidator) {
+		// firstInvalid returns the index and value of the first element failing the element rules, or -1
+		// Elements are validated with the call context, so internal errors of element rules are collected
+		firstInvalid := func(ctx context.Context, iv *I18nValidator, value, param string) (int, string, error) {
+			sep, rules, found := strings.Cut(param, ":")
+			if !found || sep == "" || strings.TrimSpace(rules) == "" {
+				return 0, "", fmt.Errorf("%s: expected <sep>:<rules>, got %q", tag, param)
+			}
+
+			for i, item := range strings.Split(value, sep) {
+				item = strings.TrimSpace(item)
+				err := iv.validator.VarCtx(ctx, item, rules)
+				if _, ok := err.(validator.ValidationErrors); ok {
+					return i, item, nil
+				} else if err != nil {
+					return 0, "", fmt.Errorf("%s: %w", tag, err)
+				}
+			}
+			return -1, "", nil
+		}
+
+		// Capture the failing element for the message instead of validating the list again on translation
+		iv.register(func(iv *I18nValidator, engine *validator.Validate) {
+			engine.RegisterValidationCtx(tag, func(ctx context.Context, fl validator.FieldLevel) bool {
+				index, item, err := firstInvalid(ctx, iv, iv.fieldLevel(fl).Field().String(), fl.Param())
+				if err != nil {
+					collect(ctx, err)
+					return false
+				} else if index >= 0 {
+					collectExtra(ctx, tag, fl.Param(), fl.Field().Interface(), map[string]any{"index": index, "item": item})
+				}
+				return index < 0
+			})
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithUniqueValidator adds validation for slices and arrays without duplicate elements.
+// Elements must be comparable, use a struct field name rule parameter (e.g. unique_list=ID) to compare struct elements by field.
+// The first duplicate value is passed to the message as {param}.
+// Non-comparable elements and unknown fields are reported as internal error.
+func WithUniqueValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("unique_list", rule...)
+	messages = resolveMessages(
+		messages,
+		"{field} contains duplicate value {param}",
+	)
+
+	// duplicate returns the first duplicate element (or element field) of the list
+	duplicate := func(list reflect.Value, field string) (any, bool, error) {
+		if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+			return nil, false, fmt.Errorf("%s: expected slice or array, got %s", tag, list.Kind())
+		}
+
+		seen := make(map[any]struct{}, list.Len())
+		for i := range list.Len() {
+			item := list.Index(i)
+			if field != "" {
+				f, ok := structField(item, field)
+				if !ok {
+					return nil, false, fmt.Errorf("%s: unknown field %q", tag, field)
+				}
+				item = f
+			}
+
+			if !item.Comparable() {
+				return nil, false, fmt.Errorf("%s: %s elements are not comparable", tag, item.Type())
+			}
+
+			value := item.Interface()
+			if _, exists := seen[value]; exists {
+				return value, true, nil
+			}
+			seen[value] = struct{}{}
+		}
+		return nil, false, nil
+	}
+
+	return func(iv *I18nValidator) {
+		iv.AddValidationWithError(tag, func(fl validator.FieldLevel) (bool, error) {
+			_, found, err := duplicate(fl.Field(), fl.Param())
+			return !found, err
+		})
+		iv.extractors[tag] = func(param string, value any) map[string]any {
+			if dup, found, err := duplicate(reflect.ValueOf(value), param); err == nil && found {
+				return map[string]any{"param": dup}
+			}
+			return nil
+		}
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithDynamicEnumValidator adds validation for values within an allowed list resolved at validation time.
+// The provider is called on each validation (and message translation) to get the current allowed values, which are passed
+// to the message as {allowed}. Validation methods can run concurrently, so the provider must be safe for concurrent use.
+func WithDynamicEnumValidator(tag string, provider func() []string, messages map[string]string) Options {
+	tag = resolveParams("dynamic_enum", tag)
+	messages = resolveMessages(
+		messages,
+		"Must be one of: {allowed}",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return slices.Contains(provider(), fmt.Sprint(fl.Field().Interface()))
+		})
+		iv.extractors[tag] = func(string, any) map[string]any {
+			return map[string]any{"allowed": strings.Join(provider(), ", ")}
+		}
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithSameDateValidator adds validation for Jalaali date strings representing the same day as a sibling Gregorian field.
+// Rule parameter is the Gregorian field name and optional Jalaali layout separated by space (e.g. same_date=BirthDate 2006/01/02),
+// layout defaults to 2006-01-02. Gregorian field can be time.Time or string in 2006-01-02 form. Parse failures fail the validation.
+func WithSameDateValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("same_date", rule...)
+	messages = resolveMessages(
+		messages,
+		"{field} must be the same date as {other}",
+	)
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			params := splitParams(fl.Param())
+			if len(params) == 0 || len(params) > 2 {
+				return false
+			}
+			layout := "2006-01-02"
+			if len(params) == 2 {
+				layout = params[1]
+			}
+
+			// Resolve the gregorian date of sibling field
+			other, _, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), params[0])
+			if !ok {
+				return false
+			}
+			var gregorian time.Time
+			switch v := other.Interface().(type) {
+			case time.Time:
+				gregorian = v
+			case string:
+				t, err := time.Parse("2006-01-02", v)
+				if err != nil {
+					return false
+				}
+				gregorian = t
+			default:
+				return false
+			}
+
+			// Parse and convert the jalaali date
+			d, err := gojalaali.Parse(layout, fl.Field().String())
+			if err != nil || d.IsZero() {
+				return false
+			}
+
+			jy, jm, jd := d.Time().Date()
+			gy, gm, gd := gregorian.Date()
+			return jy == gy && jm == gm && jd == gd
+		})
+		iv.fieldRefs[tag] = func(param string) string {
+			if params := splitParams(param); len(params) > 0 {
+				return params[0]
+			}
+			return ""
+		}
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithTimeInJalaaliRangeValidator adds validation for time.Time fields whose Jalaali date is within the inclusive range.
+// Rule parameter is the min and max Jalaali dates in 2006-01-02 form separated by space (e.g. time_jalaali_range=1400-01-01 1402-12-29).
+// The date is resolved in the time location. Zero time fails the validation, invalid parameters or non time fields produce an internal error.
+func WithTimeInJalaaliRangeValidator(messages map[string]string, rule ...string) Options {
+	tag := resolveParams("time_jalaali_range", rule...)
+	messages = resolveMessages(
+		messages,
+		"{field} must be between {param0} and {param1}",
+	)
+
+	// dateKey converts jalaali date to comparable number
+	dateKey := func(d gojalaali.Jalaali) int {
+		y, m, day := d.Date()
+		return y*10000 + int(m)*100 + day
+	}
+
+	return func(iv *I18nValidator) {
+		iv.AddValidationWithError(tag, func(fl validator.FieldLevel) (bool, error) {
+			t, ok := fl.Field().Interface().(time.Time)
+			if !ok {
+				return false, fmt.Errorf("%s: expected time.Time field, got %s", tag, fl.Field().Type())
+			}
+
+			params := splitParams(fl.Param())
+			if len(params) != 2 {
+				return false, fmt.Errorf("%s: expected min and max jalaali date, got %q", tag, fl.Param())
+			}
+			lower, err := gojalaali.Parse("2006-01-02", params[0])
+			if err != nil {
+				return false, fmt.Errorf("%s: invalid min date: %w", tag, err)
+			}
+			upper, err := gojalaali.Parse("2006-01-02", params[1])
+			if err != nil {
+				return false, fmt.Errorf("%s: invalid max date: %w", tag, err)
+			}
+
+			if t.IsZero() {
+				return false, nil
+			}
+			d := dateKey(gojalaali.New(t))
+			return d >= dateKey(lower) && d <= dateKey(upper), nil
+		})
+		for l, m := range messages {
+			iv.AddTranslation(l, tag, m)
+		}
+	}
+}
+
+// WithBlocklistPasswordValidator adds validation for passwords not in the blocklist, ignoring case and surrounding spaces.
+// The caller supplies the list (e.g. top 10k common passwords), the lookup set is built once.
+func WithBlocklistPasswordValidator(blocklist []string, messages map[string]string, rule ...string) Options {
+	tag := resolveParams("not_common_password", rule...)
+	messages = resolveMessages(
+		messages,
+		"Password is too common",
+	)
+
+	set := make(map[string]struct{}, len(blocklist))
+	for _, password := range blocklist {
+		set[strings.ToLower(strings.TrimSpace(password))] = struct{}{}
+	}
+
+	return func(iv *I18nValidator) {
+		iv.AddValidation(tag, func(fl validator.FieldLevel) bool {
+			return funcs.IsNotCommonPassword(fl.Field().String(), set)
 		})
 		for l, m := range messages {
 			iv.AddTranslation(l, tag, m)
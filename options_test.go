@@ -0,0 +1,1153 @@
+package govalidator_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mekramy/goi18n"
+	"github.com/mekramy/govalidator"
+	"golang.org/x/text/language"
+)
+
+// newTestValidator creates a validator with english translator and given options.
+func newTestValidator(options ...govalidator.Options) govalidator.Validator {
+	return govalidator.NewValidator(
+		validator.New(),
+		append(
+			[]govalidator.Options{
+				govalidator.WithTranslator(
+					goi18n.NewTranslator("en", language.English),
+					"",
+				),
+			},
+			options...,
+		)...,
+	)
+}
+
+// newTestFile creates a multipart file header with given name and content.
+func newTestFile(t *testing.T, name string, content []byte) *multipart.FileHeader {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := part.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return form.File["file"][0]
+}
+
+func TestURLValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithURLValidator(nil))
+
+	if err := v.Var("", "webhook", "https://example.com/hook", "url_scheme=https http"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "webhook", "ftp://example.com/hook", "url_scheme=https http"); !err.IsFailedOn("webhook", "url_scheme") {
+		t.Fatal("expected url_scheme error, got none")
+	}
+}
+
+func TestMalwareHashValidator(t *testing.T) {
+	type Upload struct {
+		File *multipart.FileHeader `validate:"malware_hash"`
+	}
+
+	malware := []byte("malicious content")
+	sum := sha256.Sum256(malware)
+	denied := hex.EncodeToString(sum[:])
+
+	v := newTestValidator(
+		govalidator.WithMalwareHashValidator(
+			"sha256",
+			func(hash string) (bool, error) { return hash == denied, nil },
+			nil,
+		),
+	)
+
+	t.Run("Clean", func(t *testing.T) {
+		err := v.Struct("", Upload{File: newTestFile(t, "clean.txt", []byte("clean content"))})
+		if err.HasError() {
+			t.Fatal("expected no errors, got some")
+		}
+	})
+
+	t.Run("Malicious", func(t *testing.T) {
+		err := v.Struct("", Upload{File: newTestFile(t, "malware.txt", malware)})
+		if err.HasInternalError() {
+			t.Fatal(err.InternalError())
+		} else if !err.IsFailedOn("File", "malware_hash") {
+			t.Fatal("expected malware_hash error, got none")
+		}
+	})
+
+	t.Run("CheckerError", func(t *testing.T) {
+		failure := errors.New("denylist unavailable")
+		v := newTestValidator(
+			govalidator.WithMalwareHashValidator(
+				"sha256",
+				func(hash string) (bool, error) { return false, failure },
+				nil,
+			),
+		)
+
+		err := v.Struct("", Upload{File: newTestFile(t, "clean.txt", []byte("clean content"))})
+		if !errors.Is(err.InternalError(), failure) {
+			t.Fatal("expected checker error as internal error")
+		}
+	})
+}
+
+func TestGeoConsistencyValidator(t *testing.T) {
+	type Address struct {
+		PostalCode string
+		Lat        float64
+		Lng        float64
+	}
+
+	v := newTestValidator(
+		govalidator.WithGeoConsistencyValidator(
+			"PostalCode", "Lat", "Lng",
+			func(postal string) (float64, float64, bool) {
+				if postal == "1234567890" {
+					return 35.6892, 51.3890, true // Tehran
+				}
+				return 0, 0, false
+			},
+			10,
+			nil,
+			Address{},
+		),
+	)
+
+	t.Run("CloseMatch", func(t *testing.T) {
+		err := v.Struct("", Address{PostalCode: "1234567890", Lat: 35.70, Lng: 51.40})
+		if err.HasError() {
+			t.Fatal("expected no errors, got some")
+		}
+	})
+
+	t.Run("FarMismatch", func(t *testing.T) {
+		err := v.Struct("", Address{PostalCode: "1234567890", Lat: 32.6546, Lng: 51.6680}) // Isfahan
+		if !err.IsFailedOn("PostalCode", "geo_consistency") {
+			t.Fatal("expected geo_consistency error, got none")
+		}
+	})
+
+	t.Run("UnknownPostalCode", func(t *testing.T) {
+		err := v.Struct("", Address{PostalCode: "0000000000", Lat: 35.70, Lng: 51.40})
+		if !err.IsFailedOn("PostalCode", "geo_consistency") {
+			t.Fatal("expected geo_consistency error, got none")
+		}
+	})
+
+	t.Run("TagResolver", func(t *testing.T) {
+		type Location struct {
+			PostalCode string  `json:"postal_code"`
+			Lat        float64 `json:"lat"`
+			Lng        float64 `json:"lng"`
+		}
+		v := newTestValidator(
+			govalidator.WithJSONTagResolver(),
+			govalidator.WithGeoConsistencyValidator("PostalCode", "Lat", "Lng", nil, 10, nil, Location{}),
+		)
+
+		err := v.Struct("", Location{PostalCode: "1234567890"})
+		if !err.IsFailedOn("postal_code", "geo_consistency") {
+			t.Fatalf("expected geo_consistency error on resolved name, got %v", err.Rules())
+		}
+	})
+
+	t.Run("NoTypes", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected panic without struct types")
+			}
+		}()
+		govalidator.WithGeoConsistencyValidator("PostalCode", "Lat", "Lng", nil, 10, nil)
+	})
+}
+
+func TestPrefixedUUIDValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithPrefixedUUIDValidator(nil))
+
+	if err := v.Var("", "customer", "cus_3f2504e0-4f89-41d3-9a0c-0305e82c3301", "prefixed_uuid=cus"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "customer", "ord_3f2504e0-4f89-41d3-9a0c-0305e82c3301", "prefixed_uuid=cus"); !err.IsFailedOn("customer", "prefixed_uuid") {
+		t.Fatal("expected prefixed_uuid error, got none")
+	}
+}
+
+func TestFuzzyEnumValidator(t *testing.T) {
+	v := newTestValidator(
+		govalidator.WithFuzzyEnumValidator("category", []string{"electronics", "books"}, 1, nil),
+	)
+
+	if err := v.Var("", "category", "bokks", "category"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "category", "furniture", "category"); !err.IsFailedOn("category", "category") {
+		t.Fatal("expected category error, got none")
+	}
+}
+
+func TestFileValidators(t *testing.T) {
+	type Upload struct {
+		Avatar *multipart.FileHeader `validate:"required,filesize=1B 1KB,filetype=image/png"`
+	}
+
+	v := newTestValidator(
+		govalidator.WithFileSizeValidator(nil),
+		govalidator.WithFileTypeValidator(nil),
+	)
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	t.Run("Valid", func(t *testing.T) {
+		err := v.Struct("", Upload{Avatar: newTestFile(t, "avatar.png", png)})
+		if err.HasError() {
+			t.Fatal("expected no errors, got some")
+		}
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		err := v.Struct("", Upload{Avatar: newTestFile(t, "avatar.png", append(png, bytes.Repeat([]byte{0}, 2048)...))})
+		if err.HasInternalError() {
+			t.Fatal(err.InternalError())
+		} else if !err.IsFailedOn("Avatar", "filesize") {
+			t.Fatal("expected filesize error, got none")
+		}
+	})
+
+	t.Run("WrongType", func(t *testing.T) {
+		err := v.Struct("", Upload{Avatar: newTestFile(t, "avatar.png", []byte("plain text"))})
+		if err.HasInternalError() {
+			t.Fatal(err.InternalError())
+		} else if !err.IsFailedOn("Avatar", "filetype") {
+			t.Fatal("expected filetype error, got none")
+		}
+	})
+
+	t.Run("InvalidParam", func(t *testing.T) {
+		type Document struct {
+			File *multipart.FileHeader `validate:"filesize=1B"`
+		}
+
+		err := v.Struct("", Document{File: newTestFile(t, "doc.png", png)})
+		if !err.HasInternalError() {
+			t.Fatal("expected internal error, got none")
+		}
+	})
+}
+
+func TestSignByTypeValidator(t *testing.T) {
+	type Entry struct {
+		Type   string
+		Amount float64
+	}
+
+	v := newTestValidator(
+		govalidator.WithSignByTypeValidator(
+			"Type", "Amount",
+			[]string{"credit"}, []string{"debit"},
+			map[string]string{"": "{field} sign does not match the entry type"},
+			Entry{},
+		),
+	)
+
+	t.Run("PositiveCredit", func(t *testing.T) {
+		if err := v.Struct("", Entry{Type: "credit", Amount: 100}); err.HasError() {
+			t.Fatal("expected no errors, got some")
+		}
+	})
+
+	t.Run("PositiveDebit", func(t *testing.T) {
+		err := v.Struct("", Entry{Type: "debit", Amount: 100})
+		if !err.IsFailedOn("Amount", "sign_by_type") {
+			t.Fatal("expected sign_by_type error, got none")
+		} else if msg := err.Errors()["Amount"]["sign_by_type"]; msg != "Amount sign does not match the entry type" {
+			t.Fatalf("unexpected message %q", msg)
+		}
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		if err := v.Struct("", Entry{Type: "refund", Amount: 100}); !err.IsFailedOn("Amount", "sign_by_type") {
+			t.Fatal("expected sign_by_type error, got none")
+		}
+	})
+
+	t.Run("TagResolver", func(t *testing.T) {
+		type Transaction struct {
+			Type   string  `json:"type"`
+			Amount float64 `json:"amount"`
+		}
+		v := newTestValidator(
+			govalidator.WithJSONTagResolver(),
+			govalidator.WithSignByTypeValidator("Type", "Amount", []string{"credit"}, nil, nil, Transaction{}),
+		)
+
+		err := v.Struct("", Transaction{Type: "credit", Amount: -5})
+		if !err.IsFailedOn("amount", "sign_by_type") {
+			t.Fatalf("expected sign_by_type error on resolved name, got %v", err.Rules())
+		}
+	})
+
+	t.Run("NoTypes", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected panic without struct types")
+			}
+		}()
+		govalidator.WithSignByTypeValidator("Type", "Amount", []string{"credit"}, nil, nil)
+	})
+}
+
+func TestKnownLocaleValidator(t *testing.T) {
+	translator := goi18n.NewTranslator("en", language.English)
+	translator.AddLocale("fa", nil)
+	v := govalidator.NewValidator(
+		validator.New(),
+		govalidator.WithTranslator(translator, ""),
+		govalidator.WithKnownLocaleValidator(nil),
+	)
+	v.AddTranslation("en", "is_valid", "{field} must be valid")
+	v.AddTranslation("fa", "is_valid", "{field} معتبر نیست")
+
+	if !v.HasLocale("fa") || v.HasLocale("de") {
+		t.Fatal("expected only registered locales")
+	}
+
+	if err := v.Var("en", "locale", "fa", "known_locale"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	err := v.Var("en", "locale", "de", "known_locale")
+	if !err.IsFailedOn("locale", "known_locale") {
+		t.Fatal("expected known_locale error, got none")
+	} else if msg := err.Errors()["locale"]["known_locale"]; msg != "Must be one of the supported locales: en, fa" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestAlias(t *testing.T) {
+	v := newTestValidator(
+		govalidator.WithIranianMobileValidator(nil),
+		govalidator.WithIranianPhoneValidator(nil),
+		govalidator.WithAlias(
+			"iranian_contact",
+			"mobile|phone",
+			map[string]string{"": "{field} must be a valid mobile or phone number"},
+		),
+	)
+
+	if err := v.Var("", "contact", "09123456789", "iranian_contact"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	err := v.Var("", "contact", "12345", "iranian_contact")
+	if !err.IsFailedOn("contact", "iranian_contact") {
+		t.Fatal("expected iranian_contact error, got none")
+	} else if msg := err.Errors()["contact"]["iranian_contact"]; msg != "contact must be a valid mobile or phone number" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestStructValidation(t *testing.T) {
+	type Contact struct {
+		Email string
+		Phone string
+	}
+
+	v := newTestValidator(
+		govalidator.WithStructValidation(
+			func(sl validator.StructLevel) {
+				c := sl.Current().Interface().(Contact)
+				if c.Email == "" && c.Phone == "" {
+					sl.ReportError(c.Email, "Email", "Email", "email_or_phone", "")
+				}
+			},
+			Contact{},
+			map[string]map[string]string{
+				"email_or_phone": {"en": "{field} is required when phone is empty"},
+			},
+		),
+	)
+
+	if err := v.Struct("en", Contact{Phone: "09123456789"}); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	err := v.Struct("en", Contact{})
+	if msg := err.Errors()["Email"]["email_or_phone"]; msg != "Email is required when phone is empty" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestCustomTypeFunc(t *testing.T) {
+	type NullString struct {
+		String string
+		Valid  bool
+	}
+	type User struct {
+		Nickname NullString `validate:"required,min=3"`
+	}
+
+	v := newTestValidator(
+		govalidator.WithCustomTypeFunc(func(field reflect.Value) any {
+			if ns, ok := field.Interface().(NullString); ok && ns.Valid {
+				return ns.String
+			}
+			return nil
+		}, NullString{}),
+	)
+
+	if err := v.Struct("en", User{Nickname: NullString{String: "John", Valid: true}}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+	if err := v.Struct("en", User{Nickname: NullString{String: "John"}}); !err.IsFailedOn("Nickname", "required") {
+		t.Fatalf("expected required error for invalid value, got %v", err.Rules())
+	}
+	if err := v.Struct("en", User{}); !err.IsFailedOn("Nickname", "required") {
+		t.Fatalf("expected required error for zero value, got %v", err.Rules())
+	}
+	if err := v.Struct("en", User{Nickname: NullString{String: "Jo", Valid: true}}); !err.IsFailedOn("Nickname", "min") {
+		t.Fatalf("expected min error for extracted value, got %v", err.Rules())
+	}
+}
+
+func TestValuePlaceholder(t *testing.T) {
+	v := newTestValidator(
+		govalidator.WithIranianMobileValidator(map[string]string{
+			"": "«{value}» is not a valid mobile number for {field}",
+		}),
+	)
+
+	err := v.Var("", "mobile", "0912", "mobile")
+	if msg := err.Errors()["mobile"]["mobile"]; msg != "«0912» is not a valid mobile number for mobile" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	type User struct {
+		Mobile string `validate:"mobile"`
+	}
+	err = v.Struct("", User{Mobile: "123"})
+	if msg := err.Errors()["Mobile"]["mobile"]; msg != "«123» is not a valid mobile number for Mobile" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestJSONTagResolver(t *testing.T) {
+	type User struct {
+		UserName string `json:"user_name" form:"username" validate:"required"`
+		Email    string `json:",omitempty" validate:"required"`
+	}
+
+	v := newTestValidator(govalidator.WithJSONTagResolver())
+	err := v.Struct("en", User{})
+	if !err.IsFailedOn("user_name", "required") {
+		t.Fatal("expected required error keyed by user_name")
+	} else if !err.IsFailedOn("Email", "required") {
+		t.Fatal("expected required error keyed by Email")
+	}
+}
+
+func TestTagResolver(t *testing.T) {
+	type Query struct {
+		Page   int    `query:"page" json:"page_number" validate:"min=1"`
+		Sort   string `json:"sort_by" validate:"required"`
+		Hidden string `query:"-" json:"hidden" validate:"required"`
+	}
+
+	v := newTestValidator(govalidator.WithTagResolver("query", "json", "query"))
+	err := v.Struct("en", Query{})
+	if !err.IsFailedOn("page", "min") {
+		t.Fatal("expected min error keyed by page")
+	} else if !err.IsFailedOn("sort_by", "required") {
+		t.Fatal("expected required error keyed by sort_by")
+	} else if err.IsFailed("hidden") {
+		t.Fatal("expected hidden field error not keyed by json tag")
+	}
+
+	err = newTestValidator(govalidator.WithTagResolver()).Struct("en", Query{})
+	if !err.IsFailedOn("Page", "min") {
+		t.Fatal("expected empty tag list to keep field names")
+	}
+}
+
+func TestUUIDValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithUUIDValidator(nil))
+
+	if err := v.Var("", "id", "3f2504e0-4f89-41d3-9a0c-0305e82c3301", "uuid_v=4"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "id", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "uuid_v=4"); !err.IsFailedOn("id", "uuid_v") {
+		t.Fatal("expected uuid_v error, got none")
+	}
+}
+
+func TestISBNValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithISBNValidator(nil))
+
+	if err := v.Var("", "isbn", "080442957X", "isbn"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "isbn", "080442957X", "isbn=13"); !err.IsFailedOn("isbn", "isbn") {
+		t.Fatal("expected isbn error, got none")
+	}
+}
+
+func TestHexColorValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithHexColorValidator(nil))
+
+	if err := v.Var("", "color", "1a2b3c", "hexcolor=optional"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "color", "1a2b3c", "hexcolor"); !err.IsFailedOn("color", "hexcolor") {
+		t.Fatal("expected hexcolor error, got none")
+	}
+}
+
+func TestSlugValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithSlugValidator(nil))
+
+	if err := v.Var("", "slug", "my_post-1", "slug=underscore"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "slug", "my_post-1", "slug"); !err.IsFailedOn("slug", "slug") {
+		t.Fatal("expected slug error, got none")
+	}
+}
+
+func TestDomainValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithDomainValidator(nil))
+
+	if err := v.Var("", "domain", "example.com", "domain=tld"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "domain", "localhost", "domain=tld"); !err.IsFailedOn("domain", "domain") {
+		t.Fatal("expected domain error, got none")
+	}
+}
+
+func TestEnumValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithEnumValidator(map[string]string{"": "{field} must be one of: {allowed}"}))
+
+	if err := v.Var("", "status", "active", "enum=active inactive"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+	if err := v.Var("", "priority", 2, "enum=10x2C20x2C3"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	err := v.Var("", "status", "deleted", "enum=active0x2Cinactive pending")
+	if msg := err.Errors()["status"]["enum"]; msg != "status must be one of: active, inactive, pending" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+type passwordForm struct {
+	Password        string
+	PasswordConfirm string `validate:"match=Password"`
+}
+
+func (passwordForm) TranslateTitle(locale, field string) string {
+	if locale == "fa" && field == "Password" {
+		return "رمز عبور"
+	}
+	return ""
+}
+
+func TestMatchValidator(t *testing.T) {
+	translator := goi18n.NewTranslator("en", language.English)
+	translator.AddLocale("fa", nil)
+	v := govalidator.NewValidator(
+		validator.New(),
+		govalidator.WithTranslator(translator, ""),
+		govalidator.WithMatchValidator(nil),
+	)
+	v.AddTranslation("fa", "match", "{field} با {other} مطابقت ندارد")
+
+	t.Run("Valid", func(t *testing.T) {
+		if err := v.Struct("en", passwordForm{Password: "secret", PasswordConfirm: "secret"}); err.HasError() {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		err := v.Struct("en", passwordForm{Password: "secret", PasswordConfirm: "other"})
+		if msg := err.Errors()["PasswordConfirm"]["match"]; msg != "PasswordConfirm must match Password" {
+			t.Fatalf("unexpected message %q", msg)
+		}
+	})
+
+	t.Run("LocalizedLabel", func(t *testing.T) {
+		err := v.Struct("fa", passwordForm{Password: "secret", PasswordConfirm: "other"})
+		if msg := err.Errors()["PasswordConfirm"]["match"]; msg != "PasswordConfirm با رمز عبور مطابقت ندارد" {
+			t.Fatalf("unexpected message %q", msg)
+		}
+	})
+}
+
+func TestMatchValidatorNested(t *testing.T) {
+	type Credentials struct {
+		Password string
+		Confirm  string `validate:"match=Password"`
+		Repeat   string `validate:"eqfield=Password"`
+	}
+	type Signup struct {
+		Email       string
+		Credentials Credentials
+	}
+
+	v := newTestValidator(govalidator.WithMatchValidator(nil))
+	v.AddTranslation("", "eqfield", "{field} must equal {other}")
+
+	err := v.Struct("en", Signup{Credentials: Credentials{Password: "secret", Confirm: "other", Repeat: "other"}})
+	if msg := err.Errors()["Confirm"]["match"]; msg != "Confirm must match Password" {
+		t.Fatalf("unexpected match message %q", msg)
+	} else if msg := err.Errors()["Repeat"]["eqfield"]; msg != "Repeat must equal Password" {
+		t.Fatalf("unexpected eqfield message %q", msg)
+	}
+}
+
+func TestRegexValidator(t *testing.T) {
+	v := newTestValidator(
+		govalidator.WithRegexValidator("zipcode", `^[0-9]{5}(-[0-9]{4})?$`, map[string]string{
+			"": "{field} must be a valid zip code",
+		}),
+	)
+
+	for _, zip := range []string{"12345", "12345-6789"} {
+		if err := v.Var("", "zip", zip, "zipcode"); err.HasError() {
+			t.Fatalf("expected %s to be valid", zip)
+		}
+	}
+
+	err := v.Var("", "zip", "1234a", "zipcode")
+	if msg := err.Errors()["zip"]["zipcode"]; msg != "zip must be a valid zip code" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	t.Run("InvalidPattern", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected panic on invalid pattern")
+			}
+		}()
+		govalidator.WithRegexValidator("broken", `[`, nil)
+	})
+}
+
+func TestTrimSpace(t *testing.T) {
+	isValid := func(fl validator.FieldLevel) bool {
+		return fl.Field().String() == "valid"
+	}
+
+	t.Run("Enabled", func(t *testing.T) {
+		v := newTestValidator(govalidator.WithTrimSpace())
+		v.AddValidation("is_valid", isValid)
+
+		type Form struct {
+			Field string `validate:"is_valid"`
+		}
+		form := Form{Field: " valid "}
+		if err := v.Struct("", &form); err.HasError() {
+			t.Fatalf("expected no errors, got %v", err)
+		} else if form.Field != " valid " {
+			t.Fatal("expected field not to be mutated")
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		v := newTestValidator()
+		v.AddValidation("is_valid", isValid)
+		if err := v.Var("", "field", " valid ", "is_valid"); !err.IsFailedOn("field", "is_valid") {
+			t.Fatal("expected is_valid error, got none")
+		}
+	})
+}
+
+func TestNormalizedMobileValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithNormalizedMobileValidator(nil))
+
+	for _, mobile := range []string{"09123456789", "+989123456789", "00989123456789"} {
+		if err := v.Var("", "mobile", mobile, "normalized_mobile"); err.HasError() {
+			t.Fatalf("expected %s to be valid", mobile)
+		}
+	}
+
+	if err := v.Var("", "mobile", "+18123456789", "normalized_mobile"); !err.IsFailedOn("mobile", "normalized_mobile") {
+		t.Fatal("expected normalized_mobile error, got none")
+	}
+}
+
+func TestE164Validator(t *testing.T) {
+	v := newTestValidator(govalidator.WithE164Validator(nil))
+
+	if err := v.Var("", "phone", "+14155552671", "e164"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "phone", "+1-415-555-2671", "e164"); !err.IsFailedOn("phone", "e164") {
+		t.Fatal("expected e164 error, got none")
+	}
+}
+
+func TestCoordinateValidators(t *testing.T) {
+	v := newTestValidator(
+		govalidator.WithLatitudeValidator(nil),
+		govalidator.WithLongitudeValidator(nil),
+	)
+
+	type Location struct {
+		Lat float64 `validate:"latitude"`
+		Lng string  `validate:"longitude"`
+	}
+
+	if err := v.Struct("", Location{Lat: 90.0, Lng: "-180"}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Struct("", Location{Lat: 90.0001, Lng: "east"})
+	if !err.IsFailedOn("Lat", "latitude") || !err.IsFailedOn("Lng", "longitude") {
+		t.Fatalf("expected coordinate errors, got %v", err.Rules())
+	}
+}
+
+func TestPercentageValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithPercentageValidator(nil))
+
+	if err := v.Var("", "discount", "100%", "percentage=symbol"); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+	if err := v.Var("", "discount", "0", "percentage"); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Var("", "discount", "101", "percentage=symbol")
+	if msg := err.Errors()["discount"]["percentage"]; msg != "Must be a valid percentage between 0 and 100" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	if err := v.Var("", "discount", "50%", "percentage"); !err.IsFailedOn("discount", "percentage") {
+		t.Fatal("expected percentage error for symbol without symbol param")
+	}
+}
+
+func TestTimeOfDayValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithTimeOfDayValidator(nil))
+
+	if err := v.Var("", "time", "18:45", "time_of_day"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "time", "18:45:30", "time_of_day"); !err.IsFailedOn("time", "time_of_day") {
+		t.Fatal("expected time_of_day error, got none")
+	}
+
+	if err := v.Var("", "time", "18:45:30", "time_of_day=seconds"); err.HasError() {
+		t.Fatal("expected no errors with seconds, got some")
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithDurationValidator(nil))
+
+	if err := v.Var("", "timeout", "500ms", "duration"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "timeout", "2h", "duration=1s 1h"); !err.IsFailedOn("timeout", "duration") {
+		t.Fatal("expected out of range duration error, got none")
+	}
+
+	if err := v.Var("", "timeout", "10 seconds", "duration"); !err.IsFailedOn("timeout", "duration") {
+		t.Fatal("expected malformed duration error, got none")
+	}
+
+	if err := v.Var("", "timeout", "10s", "duration=1x 1h"); !err.HasInternalError() {
+		t.Fatal("expected internal error for invalid parameter")
+	}
+}
+
+func TestCardExpiryValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithCardExpiryValidator(nil))
+
+	if err := v.Var("", "expiry", time.Now().AddDate(2, 0, 0).Format("01/06"), "card_expiry"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "expiry", "01/20", "card_expiry"); !err.IsFailedOn("expiry", "card_expiry") {
+		t.Fatal("expected card_expiry error, got none")
+	}
+}
+
+func TestIPValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithIPValidator(nil))
+
+	if err := v.Var("", "ip", "2001:db8::1", "ip"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "ip", "2001:db8::1", "ip=4"); !err.IsFailedOn("ip", "ip") {
+		t.Fatal("expected ip error for v6 address when v4 required")
+	}
+
+	if err := v.Var("", "ip", "10.0.0.1", "ip=6"); !err.IsFailedOn("ip", "ip") {
+		t.Fatal("expected ip error for v4 address when v6 required")
+	}
+}
+
+func TestListValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithListValidator(map[string]string{
+		"": "item {index} of {field} is invalid: {item}",
+	}))
+
+	type Invite struct {
+		Emails string `validate:"list=0x2C:email"`
+	}
+
+	if err := v.Struct("", Invite{Emails: "a@example.com, b@example.com"}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Struct("", Invite{Emails: "a@example.com,invalid,c@example.com"})
+	if msg := err.Errors()["Emails"]["list"]; msg != "item 1 of Emails is invalid: invalid" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	if err := v.Var("", "emails", "a@example.com;b", "list=;:required0x2Cemail"); !err.IsFailedOn("emails", "list") {
+		t.Fatal("expected list error, got none")
+	}
+
+	if err := v.Var("", "emails", "a@example.com", "list=email"); !err.HasInternalError() {
+		t.Fatal("expected internal error for invalid parameter")
+	}
+
+	v.AddValidationWithError("reachable", func(fl validator.FieldLevel) (bool, error) {
+		return false, errors.New("dns lookup failed")
+	})
+	if err := v.Var("", "emails", "a@example.com", "list=0x2C:reachable"); !err.HasInternalError() {
+		t.Fatal("expected internal error of element rule")
+	} else if err.InternalError().Error() != "dns lookup failed" {
+		t.Fatalf("unexpected internal error %v", err.InternalError())
+	}
+}
+
+func TestUniqueValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithUniqueValidator(nil))
+
+	type Tag struct {
+		ID   int
+		Name string
+	}
+	type Post struct {
+		Tags  []string `validate:"unique_list"`
+		Items []Tag    `validate:"unique_list=ID"`
+	}
+
+	if err := v.Struct("", Post{Tags: []string{"go", "rust"}, Items: []Tag{{1, "a"}, {2, "a"}}}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Struct("", Post{Tags: []string{"go", "rust", "go"}, Items: []Tag{{1, "a"}, {2, "b"}, {1, "c"}}})
+	if msg := err.Errors()["Tags"]["unique_list"]; msg != "Tags contains duplicate value go" {
+		t.Fatalf("unexpected message %q", msg)
+	} else if msg := err.Errors()["Items"]["unique_list"]; msg != "Items contains duplicate value 1" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	if err := v.Var("", "ids", []int{1, 2, 2}, "unique_list"); !err.IsFailedOn("ids", "unique_list") {
+		t.Fatal("expected unique_list error, got none")
+	}
+}
+
+func TestParamExtractor(t *testing.T) {
+	type bounds struct {
+		Min int `json:"min"`
+		Max int `json:"max"`
+	}
+
+	v := newTestValidator(
+		govalidator.WithParamExtractor("length_json", func(param string) map[string]any {
+			var b bounds
+			if err := json.Unmarshal([]byte(param), &b); err != nil {
+				return nil
+			}
+			return map[string]any{"min": b.Min, "max": b.Max}
+		}),
+	)
+	v.AddValidation("length_json", func(fl validator.FieldLevel) bool {
+		var b bounds
+		if err := json.Unmarshal([]byte(fl.Param()), &b); err != nil {
+			return false
+		}
+		l := len(fl.Field().String())
+		return l >= b.Min && l <= b.Max
+	})
+	v.AddTranslation("", "length_json", "{field} length must be {min}–{max}")
+
+	type User struct {
+		Name string `validate:"length_json={\"min\":3 0x2C \"max\":10}"`
+	}
+
+	if err := v.Struct("", User{Name: "John"}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Struct("", User{Name: "Jo"})
+	if msg := err.Errors()["Name"]["length_json"]; msg != "Name length must be 3–10" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestNumberFormatter(t *testing.T) {
+	translator := goi18n.NewTranslator("en", language.English)
+	translator.AddLocale("fa", nil)
+	v := govalidator.NewValidator(
+		validator.New(),
+		govalidator.WithTranslator(translator, ""),
+		govalidator.WithNumberFormatter(func(locale string, n any) string {
+			raw := fmt.Sprint(n)
+			if locale != "fa" {
+				return raw
+			}
+
+			// Group digits by thousands and convert to Persian digits
+			var grouped strings.Builder
+			for i, c := range raw {
+				if i > 0 && (len(raw)-i)%3 == 0 {
+					grouped.WriteRune('٬')
+				}
+				grouped.WriteRune('۰' + c - '0')
+			}
+			return grouped.String()
+		}),
+	)
+	v.AddTranslation("en", "min", "{field} must be at least {param}")
+	v.AddTranslation("fa", "min", "{field} باید حداقل {param} باشد")
+
+	if msg := v.Var("fa", "price", 10, "min=1000").Errors()["price"]["min"]; msg != "price باید حداقل ۱٬۰۰۰ باشد" {
+		t.Fatalf("unexpected fa message %q", msg)
+	}
+	if msg := v.Var("en", "price", 10, "min=1000").Errors()["price"]["min"]; msg != "price must be at least 1000" {
+		t.Fatalf("unexpected en message %q", msg)
+	}
+}
+
+func TestInternalErrorTranslator(t *testing.T) {
+	failure := errors.New("connection refused")
+	v := newTestValidator(
+		govalidator.WithInternalErrorTranslator(func(locale string, err error) string {
+			switch {
+			case errors.Is(err, govalidator.ErrNotAStruct) && locale == "fa":
+				return "ورودی نامعتبر است"
+			case errors.Is(err, govalidator.ErrNotAStruct):
+				return "invalid input"
+			default:
+				return ""
+			}
+		}),
+	)
+	v.AddValidationWithError("remote", func(fl validator.FieldLevel) (bool, error) {
+		return false, failure
+	})
+
+	err := v.Struct("en", 10)
+	if msg := err.InternalMessage("en"); msg != "invalid input" {
+		t.Fatalf("unexpected en message %q", msg)
+	} else if msg := err.InternalMessage("fa"); msg != "ورودی نامعتبر است" {
+		t.Fatalf("unexpected fa message %q", msg)
+	} else if msg := err.Only("Name").InternalMessage("en"); msg != "invalid input" {
+		t.Fatalf("expected filtered errors to keep translator, got %q", msg)
+	}
+
+	if msg := v.Var("en", "code", "x", "remote").InternalMessage("en"); msg != failure.Error() {
+		t.Fatalf("expected fallback to internal error message, got %q", msg)
+	}
+	if msg := v.Var("en", "code", "x", "required").InternalMessage("en"); msg != "" {
+		t.Fatalf("expected empty message without internal error, got %q", msg)
+	}
+}
+
+func TestDynamicEnumValidator(t *testing.T) {
+	var mutex sync.RWMutex
+	categories := []string{"books", "music"}
+	provider := func() []string {
+		mutex.RLock()
+		defer mutex.RUnlock()
+		return slices.Clone(categories)
+	}
+
+	v := newTestValidator(govalidator.WithDynamicEnumValidator("category", provider, nil))
+
+	if err := v.Var("", "category", "games", "category"); !err.IsFailedOn("category", "category") {
+		t.Fatal("expected category error, got none")
+	} else if msg := err.Errors()["category"]["category"]; msg != "Must be one of: books, music" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	mutex.Lock()
+	categories = append(categories, "games")
+	mutex.Unlock()
+
+	if err := v.Var("", "category", "games", "category"); err.HasError() {
+		t.Fatal("expected no errors after updating categories")
+	}
+}
+
+func TestJalaaliValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithJalaaliValidator(nil))
+
+	for _, date := range []string{"1402-01-15", "1403-12-30"} {
+		if err := v.Var("", "date", date, "jalaali=2006-01-02"); err.HasError() {
+			t.Fatalf("expected %s to be valid", date)
+		}
+	}
+
+	for _, date := range []string{"1402-13-10", "1402-12-30", "1402-07-31", "1402-1-5"} {
+		if err := v.Var("", "date", date, "jalaali=2006-01-02"); !err.IsFailedOn("date", "jalaali") {
+			t.Fatalf("expected %s to be invalid", date)
+		}
+	}
+
+	for _, date := range []string{"1402-01-15T10:00:00+03:30", "1402-01-01T10:00:00+00:00", "1402-01-01T10:00:00Z"} {
+		if err := v.Var("", "date", date, "jalaali"); err.HasError() {
+			t.Fatalf("expected RFC3339 date %s to be valid", date)
+		}
+	}
+
+	if err := v.Var("", "date", "1402-00-00", "jalaali=2006-01-02"); !err.IsFailedOn("date", "jalaali") {
+		t.Fatal("expected zero month and day to be invalid")
+	}
+}
+
+func TestSameDateValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithSameDateValidator(nil))
+
+	type Record struct {
+		Gregorian time.Time
+		Jalaali   string `validate:"same_date=Gregorian"`
+	}
+
+	gregorian := time.Date(2023, time.April, 4, 0, 0, 0, 0, time.UTC)
+	if err := v.Struct("", Record{Gregorian: gregorian, Jalaali: "1402-01-15"}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Struct("", Record{Gregorian: gregorian, Jalaali: "1402-01-16"})
+	if msg := err.Errors()["Jalaali"]["same_date"]; msg != "Jalaali must be the same date as Gregorian" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	if err := v.Struct("", Record{Gregorian: gregorian, Jalaali: "invalid"}); !err.IsFailedOn("Jalaali", "same_date") {
+		t.Fatal("expected same_date error for invalid date")
+	}
+
+	type StringRecord struct {
+		Gregorian string
+		Jalaali   string `validate:"same_date=Gregorian 2006/01/02"`
+	}
+	if err := v.Struct("", StringRecord{Gregorian: "2023-04-04", Jalaali: "1402/01/15"}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+	err = v.Struct("", StringRecord{Gregorian: "2023-04-04", Jalaali: "1402/01/16"})
+	if msg := err.Errors()["Jalaali"]["same_date"]; msg != "Jalaali must be the same date as Gregorian" {
+		t.Fatalf("unexpected message with layout %q", msg)
+	}
+}
+
+func TestTimeInJalaaliRangeValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithTimeInJalaaliRangeValidator(nil))
+
+	type Invoice struct {
+		IssuedAt time.Time `validate:"time_jalaali_range=1402-01-01 1402-12-29"`
+	}
+
+	if err := v.Struct("", Invoice{IssuedAt: time.Date(2023, time.April, 4, 10, 0, 0, 0, time.UTC)}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Struct("", Invoice{IssuedAt: time.Date(2024, time.March, 20, 10, 0, 0, 0, time.UTC)}) // 1403-01-01
+	if msg := err.Errors()["IssuedAt"]["time_jalaali_range"]; msg != "IssuedAt must be between 1402-01-01 and 1402-12-29" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	if err := v.Struct("", Invoice{}); !err.IsFailedOn("IssuedAt", "time_jalaali_range") {
+		t.Fatal("expected time_jalaali_range error for zero time")
+	}
+
+	if err := v.Var("", "date", "1402-01-15", "time_jalaali_range=1402-01-01 1402-12-29"); !err.HasInternalError() {
+		t.Fatal("expected internal error for non time value")
+	}
+}
+
+func TestBlocklistPasswordValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithBlocklistPasswordValidator([]string{"123456", " QWERTY "}, nil))
+
+	if err := v.Var("", "password", "Qwerty", "not_common_password"); !err.IsFailedOn("password", "not_common_password") {
+		t.Fatal("expected not_common_password error, got none")
+	}
+
+	if err := v.Var("", "password", "c0rrect-h0rse", "not_common_password"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+}
+
+func TestPaddedNationalCodeValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithPaddedNationalCodeValidator(nil))
+
+	for _, code := range []string{"0921234562", "921234562"} {
+		if err := v.Var("", "code", code, "padded_national_code"); err.HasError() {
+			t.Fatalf("expected %s to be valid", code)
+		}
+	}
+
+	if err := v.Var("", "code", "921234563", "padded_national_code"); !err.IsFailedOn("code", "padded_national_code") {
+		t.Fatal("expected padded_national_code error, got none")
+	}
+}
+
+func TestIBANValidator(t *testing.T) {
+	v := newTestValidator(govalidator.WithIBANValidator(nil))
+
+	if err := v.Var("", "iban", "DE89370400440532013000", "iban_intl"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	if err := v.Var("", "iban", "DE8937040044053201300", "iban_intl"); !err.IsFailedOn("iban", "iban_intl") {
+		t.Fatal("expected iban_intl error, got none")
+	}
+}
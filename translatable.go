@@ -1,6 +1,13 @@
 package govalidator
 
 // Translatable defines an interface for translating validation error messages.
+//
+// Error messages of struct values are resolved in the following order:
+//  1. ValueTranslatable.TranslateErrorValue
+//  2. Translatable.TranslateError
+//  3. Registered translations of the validator
+//
+// An empty result falls back to the next step.
 type Translatable interface {
 	// TranslateError returns a localized error message for a given rule and field.
 	TranslateError(locale, rule, field string) string
@@ -11,3 +18,10 @@ type TranslatableField interface {
 	// TranslateTitle returns a localized display name for a given field.
 	TranslateTitle(locale, field string) string
 }
+
+// ValueTranslatable defines an interface for translating validation error messages using the offending value.
+type ValueTranslatable interface {
+	// TranslateErrorValue returns a localized error message for a given rule and field.
+	// Value is the offending field value and param is the raw rule parameter (e.g. "3" for min=3).
+	TranslateErrorValue(locale, rule, field string, value, param any) string
+}
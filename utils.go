@@ -1,9 +1,15 @@
 package govalidator
 
 import (
+	"fmt"
+	"mime/multipart"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
 )
 
 // toChars converts a string into a slice of single-character strings.
@@ -37,9 +43,9 @@ func resolveMessages(messages map[string]string, def string) map[string]string {
 
 // parseNumeric attempts to parse a string into an integer or a float.
 func parseNumeric(v string) (*int64, *float64) {
-	if i, err := strconv.ParseInt(v, 10, 64); err != nil {
+	if i, err := strconv.ParseInt(v, 10, 64); err == nil {
 		return &i, nil
-	} else if f, err := strconv.ParseFloat(v, 64); err != nil {
+	} else if f, err := strconv.ParseFloat(v, 64); err == nil {
 		return nil, &f
 	}
 	return nil, nil
@@ -71,3 +77,299 @@ func parseTag(value any, field, tag string) (string, bool) {
 	// Return false if the field or tag is not found
 	return "", false
 }
+
+// toFileHeader resolves the multipart file header from a validation field.
+// Validator dereferences pointer fields, so both pointer and value forms are accepted.
+func toFileHeader(field reflect.Value) (*multipart.FileHeader, bool) {
+	switch f := field.Interface().(type) {
+	case *multipart.FileHeader:
+		return f, f != nil
+	case multipart.FileHeader:
+		return &f, true
+	default:
+		return nil, false
+	}
+}
+
+// toFloat converts numeric or numeric string reflect value to float64.
+func toFloat(v reflect.Value) (float64, bool) {
+	// Dereference pointer to access the underlying value
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// structField returns the named field of a struct reflect value, dereferencing pointers.
+func structField(v reflect.Value, name string) (reflect.Value, bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	f := v.FieldByName(name)
+	return f, f.IsValid()
+}
+
+// splitParams splits a rule parameter into parts separated by whitespace or pipe.
+// Pipe is the validator "or" operator in tags, so it must be escaped as 0x7C there.
+func splitParams(param string) []string {
+	return strings.FieldsFunc(param, func(r rune) bool {
+		return r == '|' || unicode.IsSpace(r)
+	})
+}
+
+// crossFieldRules contains the validator rules whose parameter references another struct field.
+var crossFieldRules = map[string]struct{}{
+	"eqfield": {}, "nefield": {}, "gtfield": {}, "gtefield": {}, "ltfield": {}, "ltefield": {},
+	"eqcsfield": {}, "necsfield": {}, "gtcsfield": {}, "gtecsfield": {}, "ltcsfield": {}, "ltecsfield": {},
+	"fieldcontains": {}, "fieldexcludes": {},
+}
+
+// hasFieldPath checks if the dot separated field path (e.g. Inner.Field) exists on the struct type.
+func hasFieldPath(t reflect.Type, path string) bool {
+	if t == nil || path == "" {
+		return false
+	}
+
+	for _, name := range strings.Split(path, ".") {
+		// Dereference pointer type to access the underlying type
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		// Ensure the type is a struct before looking for the field
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+
+		f, ok := t.FieldByName(name)
+		if !ok {
+			return false
+		}
+		t = f.Type
+	}
+
+	return true
+}
+
+// shadowedDepth checks whether the field addressed by struct namespace (e.g. Post.Audit.CreatedBy) is promoted
+// from an embedded struct and shadowed by another field with the same name in the containing struct.
+// It returns the number of trailing namespace segments (embedded path and field) needed to keep the key distinct.
+func shadowedDepth(value any, namespace string) (int, bool) {
+	segments := strings.Split(namespace, ".")
+	if len(segments) < 3 || strings.Contains(namespace, "[") {
+		return 0, false
+	}
+
+	// Walk the namespace, tracking the field index path relative to the nearest non-embedded struct
+	container := derefType(reflect.TypeOf(value))
+	t := container
+	var index []int
+	for i, name := range segments[1:] {
+		if t == nil || t.Kind() != reflect.Struct {
+			return 0, false
+		}
+
+		f, ok := directField(t, name)
+		if !ok {
+			return 0, false
+		}
+		index = append(index, f.Index[0])
+		t = derefType(f.Type)
+
+		// Regular nested struct starts a new container
+		if !f.Anonymous && i < len(segments)-2 {
+			container = t
+			index = nil
+		}
+	}
+
+	// Field is not promoted from an embedded struct
+	if len(index) < 2 {
+		return 0, false
+	}
+
+	// Field is shadowed if the promoted lookup resolves to another field or is ambiguous
+	f, ok := container.FieldByName(segments[len(segments)-1])
+	if ok && slices.Equal(f.Index, index) {
+		return 0, false
+	}
+	return len(index), true
+}
+
+// directField returns the field declared directly on struct type t, ignoring promoted fields.
+func directField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		if f := t.Field(i); f.Name == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// derefType dereferences pointer types to the underlying type.
+func derefType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// checkStruct ensures the value is a non-nil struct or pointer to struct.
+func checkStruct(value any) error {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return ErrNilValue
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return ErrNilValue
+	case reflect.Struct:
+		return nil
+	default:
+		return fmt.Errorf("%w, got %T", ErrNotAStruct, value)
+	}
+}
+
+// parentType resolves the struct type declaring the field addressed by struct namespace (e.g. Order.Items[0].Name)
+// and the field name, following pointers, interfaces and slice, array or map elements of value on the way,
+// so structs held by interface fields resolve to their dynamic type.
+func parentType(value any, namespace string) (reflect.Type, string, bool) {
+	v := derefValue(reflect.ValueOf(value))
+	segments := strings.Split(namespace, ".")
+	if !v.IsValid() || len(segments) < 2 {
+		return nil, "", false
+	}
+
+	for _, segment := range segments[1 : len(segments)-1] {
+		if v.Kind() != reflect.Struct {
+			return nil, "", false
+		}
+
+		name, indexes, _ := strings.Cut(segment, "[")
+		v = derefValue(v.FieldByName(name))
+
+		// Resolve element for each index of the segment (e.g. Items[0] or Meta[key])
+		if indexes != "" {
+			for _, index := range strings.Split(strings.TrimSuffix(indexes, "]"), "][") {
+				v = derefValue(elementAt(v, index))
+			}
+		}
+		if !v.IsValid() {
+			return nil, "", false
+		}
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, "", false
+	}
+
+	name, _, _ := strings.Cut(segments[len(segments)-1], "[")
+	return v.Type(), name, true
+}
+
+// derefValue dereferences pointers and interfaces to the underlying value.
+// Nil pointers and interfaces resolve to invalid value.
+func derefValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+// elementAt returns the slice or array element at index or the map element with key formatted as index.
+// Invalid value is returned if element not found.
+func elementAt(v reflect.Value, index string) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if i, err := strconv.Atoi(index); err == nil && i >= 0 && i < v.Len() {
+			return v.Index(i)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if fmt.Sprint(iter.Key().Interface()) == index {
+				return iter.Value()
+			}
+		}
+	}
+	return reflect.Value{}
+}
+
+// inlineMessage returns the message of rule defined in the message tag of the field addressed by struct namespace.
+// Tag entries are separated by semicolon in rule=message form (e.g. msg:"required=Name is mandatory;min=Too short").
+func inlineMessage(value any, namespace, tag, rule string) (string, bool) {
+	parent, field, ok := parentType(value, namespace)
+	if !ok {
+		return "", false
+	}
+
+	raw, ok := parseTag(reflect.New(parent).Interface(), field, tag)
+	if !ok {
+		return "", false
+	}
+
+	for _, entry := range strings.Split(raw, ";") {
+		if r, message, found := strings.Cut(entry, "="); found && strings.TrimSpace(r) == rule {
+			return strings.TrimSpace(message), true
+		}
+	}
+	return "", false
+}
+
+// trimmedFieldLevel wraps validator.FieldLevel to expose string fields without leading and trailing spaces.
+type trimmedFieldLevel struct {
+	validator.FieldLevel
+}
+
+func (t trimmedFieldLevel) Field() reflect.Value {
+	f := t.FieldLevel.Field()
+	if f.Kind() == reflect.String {
+		return reflect.ValueOf(strings.TrimSpace(f.String())).Convert(f.Type())
+	}
+	return f
+}
+
+// toFieldDetail extracts the raw failure details of validator field error.
+func toFieldDetail(field validator.FieldError) FieldDetail {
+	return FieldDetail{
+		Param: field.Param(),
+		Value: field.Value(),
+		Kind:  field.Kind().String(),
+	}
+}
+
+// trimNamespace removes the root struct name from the validation error namespace.
+func trimNamespace(namespace string) string {
+	if _, after, found := strings.Cut(namespace, "."); found {
+		return after
+	}
+	return namespace
+}
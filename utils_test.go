@@ -0,0 +1,34 @@
+package govalidator
+
+import "testing"
+
+func TestParseNumeric(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		i     *int64
+		f     *float64
+	}{
+		{name: "Integer", input: "42", i: ptr(int64(42))},
+		{name: "NegativeInteger", input: "-7", i: ptr(int64(-7))},
+		{name: "Float", input: "3.5", f: ptr(3.5)},
+		{name: "NonNumeric", input: "abc"},
+		{name: "Empty", input: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, f := parseNumeric(tt.input)
+			if (i == nil) != (tt.i == nil) || (i != nil && *i != *tt.i) {
+				t.Fatalf("unexpected integer result %v", i)
+			}
+			if (f == nil) != (tt.f == nil) || (f != nil && *f != *tt.f) {
+				t.Fatalf("unexpected float result %v", f)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
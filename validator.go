@@ -1,11 +1,14 @@
 package govalidator
 
 import (
+	"context"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/mekramy/goi18n"
 )
 
 // Validator defines an interface for localized validation functionality.
+// Validator is safe for concurrent validation once all validations and options are registered.
 type Validator interface {
 	// AddValidation registers a custom validation rule with a custom validation function.
 	// Parameters:
@@ -13,6 +16,27 @@ type Validator interface {
 	//   f: The validation function to be applied.
 	AddValidation(rule string, f validator.Func)
 
+	// AddValidations registers multiple custom validation rules at once.
+	// Rules with empty name are skipped.
+	// Parameters:
+	//   rules: The validation functions keyed by rule name.
+	AddValidations(rules map[string]validator.Func)
+
+	// AddValidationCtx registers a custom validation rule with a context-aware validation function.
+	// The context passed to StructCtx or VarCtx is available to f, other methods pass context.Background().
+	// Parameters:
+	//   rule: The name of the validation rule.
+	//   f: The context-aware validation function to be applied.
+	AddValidationCtx(rule string, f validator.FuncCtx)
+
+	// AddValidationWithError registers a custom validation rule with a validation function that may fail with an error.
+	// Errors returned by f are reported as the internal error of the validation result instead of a validation failure.
+	// Errors are collected per validation call, so concurrent validations never share reported errors.
+	// Parameters:
+	//   rule: The name of the validation rule.
+	//   f: The validation function to be applied.
+	AddValidationWithError(rule string, f func(fl validator.FieldLevel) (bool, error))
+
 	// AddTranslation adds a translation message for a validation rule in a specified locale.
 	// Parameters:
 	//   locale: The locale for the translation.
@@ -21,8 +45,28 @@ type Validator interface {
 	//   options: Optional pluralization options.
 	AddTranslation(locale, rule, message string, options ...goi18n.PluralOption)
 
+	// AddTranslations adds translation messages for a validation rule in multiple locales.
+	// Empty locales and messages are skipped.
+	// Parameters:
+	//   rule: The validation rule to associate with the translations.
+	//   byLocale: The translated messages keyed by locale.
+	//   options: Optional pluralization options applied to all messages.
+	AddTranslations(rule string, byLocale map[string]string, options ...goi18n.PluralOption)
+
+	// HasLocale checks if any translation is registered for the locale through AddTranslation.
+	// Parameters:
+	//   locale: The locale to check.
+	// Returns:
+	//   bool: True if the locale is registered.
+	HasLocale(locale string) bool
+
 	// Struct validates an entire struct based on its defined validation rules.
 	// Returns validation errors in the provided locale, defaulting to the translator locale if empty.
+	// Pointers are dereferenced. Nil values and non-struct values produce ErrNilValue or ErrNotAStruct
+	// as internal error, the same applies to StructExpect, StructPartial and StructFiltered.
+	// Interface fields holding a struct or pointer to struct are validated as nested structs by the engine, with
+	// errors keyed under the field namespace (e.g. Payload.Name) and inline messages resolved from the dynamic type.
+	// Nesting has no depth limit, so values with reference cycles must not be validated.
 	// Parameters:
 	//   locale: The locale for error messages.
 	//   value: The struct to validate.
@@ -30,6 +74,16 @@ type Validator interface {
 	//   ValidationError: The validation errors for the struct.
 	Struct(locale string, value any) ValidationError
 
+	// StructCtx validates an entire struct using the provided context.
+	// Context is passed to context-aware validation functions.
+	// Parameters:
+	//   ctx: The context for validation functions.
+	//   locale: The locale for error messages.
+	//   value: The struct to validate.
+	// Returns:
+	//   ValidationError: The validation errors for the struct.
+	StructCtx(ctx context.Context, locale string, value any) ValidationError
+
 	// StructExpect validates a struct while ignoring specified fields.
 	// Returns validation errors in the provided locale, defaulting to the translator locale if empty.
 	// Parameters:
@@ -50,6 +104,29 @@ type Validator interface {
 	//   ValidationError: The validation errors for the specified fields.
 	StructPartial(locale string, value any, fields ...string) ValidationError
 
+	// StructFiltered validates only fields of a struct accepted by the filter function.
+	// Filter receives the field namespace (e.g. User.Address.City) and returns true to skip the field.
+	// Returns validation errors in the provided locale, defaulting to the translator locale if empty.
+	// Parameters:
+	//   locale: The locale for error messages.
+	//   value: The struct to validate.
+	//   fn: The filter function, returning true for fields to skip.
+	// Returns:
+	//   ValidationError: The validation errors for the fields not skipped.
+	StructFiltered(locale string, value any, fn validator.FilterFunc) ValidationError
+
+	// Map validates each struct value of a map based on its defined validation rules.
+	// Errors are keyed by map key and field (e.g. [key].Field). Non-map values or non-struct
+	// map values produce an internal error.
+	// If key rules are passed, each map key is validated against them and failures are keyed as [key]:key.
+	// Parameters:
+	//   locale: The locale for error messages.
+	//   m: The map of structs to validate.
+	//   keyRules: Optional validation rules for map keys (e.g. "bcp47_language_tag").
+	// Returns:
+	//   ValidationError: The validation errors for all map keys and values.
+	Map(locale string, m any, keyRules ...string) ValidationError
+
 	// Var validates a single variable against a rule with optional custom messages.
 	// Parameters:
 	//   locale: The locale for error messages.
@@ -60,6 +137,18 @@ type Validator interface {
 	//   ValidationError: The validation errors, if any.
 	Var(locale, name string, value any, rules string) ValidationError
 
+	// VarCtx validates a single variable against a rule using the provided context.
+	// Context is passed to context-aware validation functions.
+	// Parameters:
+	//   ctx: The context for validation functions.
+	//   locale: The locale for error messages.
+	//   name: The name of the field being validated.
+	//   value: The value to validate.
+	//   rule: The validation rule to apply.
+	// Returns:
+	//   ValidationError: The validation errors, if any.
+	VarCtx(ctx context.Context, locale, name string, value any, rules string) ValidationError
+
 	// VarWithValue validates a variable against another using a rule with custom error messages.
 	// Parameters:
 	//   locale: The locale for error messages.
@@ -70,6 +159,34 @@ type Validator interface {
 	// Returns:
 	//   ValidationError: The validation errors, if any.
 	VarWithValue(locale, name string, value any, other any, rules string) ValidationError
+
+	// Vars validates multiple variables against their rules and aggregates errors keyed by item name.
+	// Validation stops on the first internal error.
+	// Parameters:
+	//   locale: The locale for error messages.
+	//   items: The variables to validate.
+	// Returns:
+	//   ValidationError: The validation errors of all variables, if any.
+	Vars(locale string, items []VarItem) ValidationError
+
+	// Clone creates an independent copy of the validator sharing the same translator.
+	// go-playground validator can't be deep-copied, so the copy uses a new validator engine and
+	// replays every validation, alias, tag name function, custom type function and struct validation registered through
+	// this package. Engine settings and registrations made directly on the original engine (SetTagName,
+	// WithRequiredStructEnabled, RegisterValidation, ...) must be recreated by newEngine.
+	// Validations added to the copy don't affect the original and vice versa.
+	// Parameters:
+	//   newEngine: The factory building the engine of the copy, validator.New() is used if nil.
+	// Returns:
+	//   Validator: The independent copy.
+	Clone(newEngine func() *validator.Validate) Validator
+}
+
+// VarItem represents a single variable validated by Vars.
+type VarItem struct {
+	Name  string // Name is the field name used as error key and {field} placeholder.
+	Value any    // Value is the value to validate.
+	Rules string // Rules is the validation rules to apply (e.g. "required,numeric").
 }
 
 // NewValidator creates a new Validator instance with optional configurations.
@@ -77,8 +194,11 @@ type Validator interface {
 func NewValidator(validator *validator.Validate, options ...Options) Validator {
 	// Initialize the I18nValidator with the provided validator
 	v := &I18nValidator{
-		translator: nil,
+		settings:   settings{messageTag: "msg"},
 		validator:  validator,
+		extractors: make(map[string]func(param string, value any) map[string]any),
+		fieldRefs:  make(map[string]func(param string) string),
+		locales:    make(map[string]struct{}),
 	}
 
 	// Apply any additional options
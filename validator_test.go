@@ -1,11 +1,21 @@
 package govalidator_test
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mekramy/goi18n"
 	"github.com/mekramy/govalidator"
+	"github.com/mekramy/govalidator/funcs"
 	"golang.org/x/text/language"
 )
 
@@ -94,4 +104,743 @@ func TestValidator(t *testing.T) {
 			t.Fatal("expected validation errors, got none")
 		}
 	})
+
+	t.Run("ValidationWithError", func(t *testing.T) {
+		v.AddValidationWithError("upload_size", func(fl validator.FieldLevel) (bool, error) {
+			file, ok := fl.Field().Interface().(multipart.FileHeader)
+			if !ok {
+				return false, nil
+			}
+			return funcs.IsValidFileSize(&file, "1B", fl.Param())
+		})
+
+		type TestStruct struct {
+			File *multipart.FileHeader `validate:"upload_size=invalid"`
+		}
+		ts := TestStruct{File: newTestFile(t, "doc.txt", []byte("content"))}
+		err := v.Struct("en", ts)
+		if !err.HasInternalError() {
+			t.Fatal("expected internal error, got none")
+		} else if err.HasValidationErrors() {
+			t.Fatal("expected no validation errors, got some")
+		}
+	})
+}
+
+func TestConcurrentValidation(t *testing.T) {
+	v := newTestValidator(govalidator.WithFileSizeValidator(nil))
+	v.AddValidation("is_valid", func(fl validator.FieldLevel) bool {
+		return fl.Field().String() == "valid"
+	})
+	v.AddTranslation("en", "is_valid", "{field} must be valid")
+
+	type TestStruct struct {
+		Field string                `validate:"required,is_valid"`
+		File  *multipart.FileHeader `validate:"omitempty,filesize=1B"`
+	}
+	file := newTestFile(t, "doc.txt", []byte("content"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			if err := v.Struct("en", TestStruct{Field: "invalid"}); err.HasInternalError() || !err.IsFailedOn("Field", "is_valid") {
+				t.Error("expected is_valid error only")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := v.Struct("en", TestStruct{Field: "valid", File: file}); !err.HasInternalError() {
+				t.Error("expected internal error, got none")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := v.Var("en", "my_field", "valid", "required,is_valid"); err.HasError() {
+				t.Error("expected no errors, got some")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMultiParamTranslation(t *testing.T) {
+	v := newTestValidator()
+	v.AddValidation("length_between", func(fl validator.FieldLevel) bool {
+		var min, max int
+		fmt.Sscanf(strings.ReplaceAll(fl.Param(), "|", " "), "%d %d", &min, &max)
+		return len(fl.Field().String()) >= min && len(fl.Field().String()) <= max
+	})
+	v.AddTranslation("en", "length_between", "{field} must be between {param0} and {param1} characters")
+
+	err := v.Var("en", "username", "ab", "length_between=30x7C10")
+	if msg := err.Errors()["username"]["length_between"]; msg != "username must be between 3 and 10 characters" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestCrossFieldTranslation(t *testing.T) {
+	v := newTestValidator()
+	v.AddTranslation("en", "eqfield", "{field} must match {other}")
+
+	type Register struct {
+		Password        string `validate:"required"`
+		PasswordConfirm string `validate:"eqfield=Password"`
+	}
+
+	err := v.Struct("en", Register{Password: "secret", PasswordConfirm: "other"})
+	if msg := err.Errors()["PasswordConfirm"]["eqfield"]; msg != "PasswordConfirm must match Password" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	err = v.VarWithValue("en", "confirm", "secret", "other", "eqfield")
+	if msg := err.Errors()["confirm"]["eqfield"]; msg != "confirm must match {other}" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestNumericParamTranslation(t *testing.T) {
+	v := newTestValidator()
+	v.AddTranslation("en", "min", "{field} must be at least {param} characters", goi18n.PluralOne("{field} must be at least one character"))
+
+	err := v.Var("en", "username", "ab", "min=3")
+	if msg := err.Errors()["username"]["min"]; msg != "username must be at least 3 characters" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	err = v.Var("en", "username", "", "min=1")
+	if msg := err.Errors()["username"]["min"]; msg != "username must be at least one character" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestNestedStructErrors(t *testing.T) {
+	v := newTestValidator(govalidator.WithErrorKeyMode(govalidator.KeyNamespace))
+
+	type Address struct {
+		Name string `validate:"required"`
+		City string `validate:"required"`
+	}
+	type Item struct {
+		Name string `validate:"required"`
+	}
+	type Order struct {
+		Name    string  `validate:"required"`
+		Address Address `validate:"required"`
+		Items   []Item  `validate:"dive"`
+	}
+
+	err := v.Struct("en", Order{Items: []Item{{Name: "book"}, {}}})
+	for _, key := range []string{"Name", "Address.Name", "Address.City", "Items[1].Name"} {
+		if !err.IsFailedOn(key, "required") {
+			t.Fatalf("expected required error on %s", key)
+		}
+	}
+	if err.IsFailed("Items[0].Name") {
+		t.Fatal("expected no error on Items[0].Name")
+	}
+}
+
+func TestErrorKeyMode(t *testing.T) {
+	type Address struct {
+		City string `validate:"required"`
+	}
+	type User struct {
+		Name    string `validate:"required"`
+		Address Address
+	}
+
+	t.Run("FieldName", func(t *testing.T) {
+		err := newTestValidator().Struct("en", User{})
+		if !err.IsFailedOn("Name", "required") || !err.IsFailedOn("City", "required") {
+			t.Fatal("expected errors keyed by field name")
+		}
+	})
+
+	t.Run("Namespace", func(t *testing.T) {
+		v := newTestValidator(govalidator.WithErrorKeyMode(govalidator.KeyNamespace))
+		err := v.Struct("en", User{})
+		if !err.IsFailedOn("Name", "required") || !err.IsFailedOn("Address.City", "required") {
+			t.Fatal("expected errors keyed by namespace")
+		}
+	})
+}
+
+func TestMap(t *testing.T) {
+	v := newTestValidator()
+
+	type User struct {
+		Name  string `validate:"required"`
+		Email string `validate:"required,email"`
+	}
+
+	t.Run("Values", func(t *testing.T) {
+		users := map[string]User{
+			"john": {Name: "John", Email: "john@example.com"},
+			"jane": {Name: "Jane", Email: "invalid"},
+		}
+
+		err := v.Map("en", users)
+		if err.HasInternalError() {
+			t.Fatal(err.InternalError())
+		} else if !err.IsFailedOn("[jane].Email", "email") {
+			t.Fatal("expected email error on [jane].Email")
+		} else if len(err.Errors()) != 1 {
+			t.Fatal("expected only one failed field")
+		}
+	})
+
+	t.Run("Keys", func(t *testing.T) {
+		users := map[string]User{
+			"en-US":     {Name: "John", Email: "john@example.com"},
+			"not a tag": {Name: "Jane", Email: "jane@example.com"},
+		}
+
+		err := v.Map("en", users, "bcp47_language_tag")
+		if err.HasInternalError() {
+			t.Fatal(err.InternalError())
+		} else if !err.IsFailedOn("[not a tag]:key", "bcp47_language_tag") {
+			t.Fatalf("expected key error on [not a tag]:key, got %v", err.Rules())
+		} else if len(err.Errors()) != 1 {
+			t.Fatal("expected only the key error")
+		}
+	})
+
+	t.Run("NotMap", func(t *testing.T) {
+		if err := v.Map("en", User{}); !err.HasInternalError() {
+			t.Fatal("expected internal error, got none")
+		}
+	})
+
+	t.Run("NonStructValues", func(t *testing.T) {
+		if err := v.Map("en", map[string]int{"a": 1}); !err.HasInternalError() {
+			t.Fatal("expected internal error, got none")
+		}
+	})
+}
+
+func TestVarCtx(t *testing.T) {
+	type ctxKey struct{}
+
+	base := validator.New()
+	base.RegisterValidationCtx("reserved", func(ctx context.Context, fl validator.FieldLevel) bool {
+		reserved, _ := ctx.Value(ctxKey{}).(string)
+		return fl.Field().String() != reserved
+	})
+	v := govalidator.NewValidator(
+		base,
+		govalidator.WithTranslator(goi18n.NewTranslator("en", language.English), ""),
+	)
+	v.AddTranslation("en", "reserved", "{field} is reserved")
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "admin")
+	if err := v.VarCtx(ctx, "en", "username", "john", "reserved"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	err := v.VarCtx(ctx, "en", "username", "admin", "reserved")
+	if msg := err.Errors()["username"]["reserved"]; msg != "username is reserved" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestAddValidationCtx(t *testing.T) {
+	type tenantKey struct{}
+	type Order struct {
+		Tenant string `validate:"required,same_tenant"`
+	}
+
+	v := newTestValidator()
+	v.AddValidationCtx("same_tenant", func(ctx context.Context, fl validator.FieldLevel) bool {
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		return fl.Field().String() == tenant
+	})
+	v.AddTranslation("", "same_tenant", "{field} doesn't belong to current tenant")
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	if err := v.StructCtx(ctx, "en", Order{Tenant: "acme"}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.StructCtx(ctx, "en", Order{Tenant: "globex"})
+	if msg := err.Errors()["Tenant"]["same_tenant"]; msg != "Tenant doesn't belong to current tenant" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	if err := v.Struct("en", Order{Tenant: "acme"}); !err.IsFailedOn("Tenant", "same_tenant") {
+		t.Fatal("expected same_tenant error without tenant in context")
+	}
+}
+
+func TestAddTranslations(t *testing.T) {
+	translator := goi18n.NewTranslator("en", language.English)
+	translator.AddLocale("fa", nil)
+	translator.AddLocale("de", nil)
+	v := govalidator.NewValidator(
+		validator.New(),
+		govalidator.WithTranslator(translator, "validation"),
+	)
+	v.AddValidation("is_valid", func(fl validator.FieldLevel) bool {
+		return fl.Field().String() == "valid"
+	})
+	v.AddTranslations("is_valid", map[string]string{
+		"en": "{field} must be valid",
+		"fa": "{field} معتبر نیست",
+		"de": "{field} muss gültig sein",
+		" ":  "ignored",
+	})
+
+	expected := map[string]string{
+		"en": "my_field must be valid",
+		"fa": "my_field معتبر نیست",
+		"de": "my_field muss gültig sein",
+	}
+	for locale, message := range expected {
+		err := v.Var(locale, "my_field", "invalid", "is_valid")
+		if msg := err.Errors()["my_field"]["is_valid"]; msg != message {
+			t.Fatalf("unexpected %s message %q", locale, msg)
+		}
+	}
+}
+
+func TestAddValidations(t *testing.T) {
+	v := newTestValidator()
+	v.AddValidations(map[string]validator.Func{
+		"is_upper": func(fl validator.FieldLevel) bool {
+			return strings.ToUpper(fl.Field().String()) == fl.Field().String()
+		},
+		"is_short": func(fl validator.FieldLevel) bool {
+			return len(fl.Field().String()) <= 3
+		},
+		" ": func(fl validator.FieldLevel) bool { return false },
+	})
+
+	if err := v.Var("en", "code", "ABC", "is_upper,is_short"); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+	if err := v.Var("en", "code", "abc", "is_upper,is_short"); !err.IsFailedOn("code", "is_upper") {
+		t.Fatal("expected is_upper error, got none")
+	}
+	if err := v.Var("en", "code", "ABCD", "is_upper,is_short"); !err.IsFailedOn("code", "is_short") {
+		t.Fatal("expected is_short error, got none")
+	}
+}
+
+func TestConditionalRequiredTranslation(t *testing.T) {
+	v := newTestValidator()
+	v.AddTranslation("en", "required_if", "{field} is required when {param0} is {param1}")
+
+	type Subscription struct {
+		Type      string
+		PaymentID string `validate:"required_if=Type premium"`
+	}
+
+	if err := v.Struct("en", Subscription{Type: "free"}); err.HasError() {
+		t.Fatal("expected no errors, got some")
+	}
+
+	err := v.Struct("en", Subscription{Type: "premium"})
+	if msg := err.Errors()["PaymentID"]["required_if"]; msg != "PaymentID is required when Type is premium" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestEmbeddedStructErrors(t *testing.T) {
+	type Audit struct {
+		CreatedBy string `validate:"required"`
+		UpdatedBy string `validate:"required"`
+	}
+	type Post struct {
+		Audit
+		CreatedBy string `validate:"required"`
+	}
+
+	t.Run("FieldName", func(t *testing.T) {
+		err := newTestValidator().Struct("en", &Post{})
+		for _, key := range []string{"CreatedBy", "Audit.CreatedBy", "UpdatedBy"} {
+			if !err.IsFailedOn(key, "required") {
+				t.Fatalf("expected required error on %s, got %v", key, err.Rules())
+			}
+		}
+		if err.Count() != 3 {
+			t.Fatalf("expected 3 errors, got %d", err.Count())
+		}
+	})
+
+	t.Run("Namespace", func(t *testing.T) {
+		v := newTestValidator(govalidator.WithErrorKeyMode(govalidator.KeyNamespace))
+		err := v.Struct("en", Post{})
+		for _, key := range []string{"CreatedBy", "Audit.CreatedBy", "Audit.UpdatedBy"} {
+			if !err.IsFailedOn(key, "required") {
+				t.Fatalf("expected required error on %s, got %v", key, err.Rules())
+			}
+		}
+	})
+}
+
+type ageForm struct {
+	Age  int    `validate:"min=18"`
+	Name string `validate:"required"`
+}
+
+func (ageForm) TranslateErrorValue(locale, rule, field string, value, param any) string {
+	if rule == "min" {
+		return fmt.Sprintf("%v is below minimum %v", value, param)
+	}
+	return ""
+}
+
+func (ageForm) TranslateError(locale, rule, field string) string {
+	return field + " fallback"
+}
+
+func TestValueTranslatable(t *testing.T) {
+	err := newTestValidator().Struct("en", ageForm{Age: 12})
+	if msg := err.Errors()["Age"]["min"]; msg != "12 is below minimum 18" {
+		t.Fatalf("unexpected message %q", msg)
+	} else if msg := err.Errors()["Name"]["required"]; msg != "Name fallback" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestInlineMessage(t *testing.T) {
+	type Address struct {
+		City string `validate:"required" msg:"required=City is mandatory"`
+	}
+	type User struct {
+		Name    string    `validate:"required,min=3" msg:"required=Name is mandatory; min=Name is too short"`
+		Email   string    `validate:"required"`
+		Address []Address `validate:"dive"`
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		err := newTestValidator().Struct("en", User{Address: []Address{{}}})
+		if msg := err.Errors()["Name"]["required"]; msg != "Name is mandatory" {
+			t.Fatalf("unexpected message %q", msg)
+		} else if msg := err.Errors()["City"]["required"]; msg != "City is mandatory" {
+			t.Fatalf("unexpected message %q", msg)
+		} else if !err.IsFailedOn("Email", "required") {
+			t.Fatal("expected required error on Email")
+		}
+
+		err = newTestValidator().Struct("en", User{Name: "Jo", Email: "jo@example.com"})
+		if msg := err.Errors()["Name"]["min"]; msg != "Name is too short" {
+			t.Fatalf("unexpected message %q", msg)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		v := govalidator.NewValidator(validator.New(), govalidator.WithMessageTag(""))
+		err := v.Struct("en", User{})
+		if msg := err.Errors()["Name"]["required"]; msg == "Name is mandatory" {
+			t.Fatal("expected inline message to be disabled")
+		}
+	})
+}
+
+func TestBailOnFirstError(t *testing.T) {
+	type User struct {
+		Name  string `validate:"required,min=3"`
+		Email string `validate:"required,email"`
+		Age   int    `validate:"min=18"`
+	}
+
+	v := newTestValidator(govalidator.WithBailOnFirstError())
+	for range 10 {
+		err := v.Struct("en", User{Name: "Jo", Email: "invalid"})
+		if len(err.Errors()) != 1 || !err.IsFailedOn("Name", "min") {
+			t.Fatalf("expected only Name error, got %v", err.Rules())
+		}
+	}
+
+	if err := newTestValidator().Struct("en", User{Name: "Jo", Email: "invalid"}); len(err.Errors()) != 3 {
+		t.Fatalf("expected 3 failing fields without bail, got %v", err.Rules())
+	}
+}
+
+func TestObserver(t *testing.T) {
+	type User struct {
+		Name  string `validate:"required"`
+		Email string `validate:"required,email"`
+	}
+
+	var kinds []string
+	var durations []time.Duration
+	var counts []int
+	v := newTestValidator(govalidator.WithObserver(func(kind string, d time.Duration, errCount int) {
+		kinds = append(kinds, kind)
+		durations = append(durations, d)
+		counts = append(counts, errCount)
+	}))
+
+	v.Struct("en", &User{})
+	v.Var("en", "email", "invalid", "email")
+	v.Map("en", map[string]User{"a": {}, "b": {Name: "John", Email: "john@example.com"}})
+
+	if !slices.Equal(kinds, []string{"govalidator_test.User", "var", "map[string]govalidator_test.User"}) {
+		t.Fatalf("unexpected kinds %v", kinds)
+	} else if !slices.Equal(counts, []int{2, 1, 2}) {
+		t.Fatalf("unexpected error counts %v", counts)
+	}
+	for _, d := range durations {
+		if d <= 0 {
+			t.Fatalf("expected positive duration, got %s", d)
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	v := newTestValidator(govalidator.WithAlias("username", "required,alphanum", map[string]string{"": "{field} is not a valid username"}))
+	clone := v.Clone(nil)
+	clone.AddValidation("even", func(fl validator.FieldLevel) bool {
+		return fl.Field().Int()%2 == 0
+	})
+	clone.AddTranslation("", "even", "{field} must be even")
+
+	if err := clone.Var("en", "number", 3, "even"); !err.IsFailedOn("number", "even") {
+		t.Fatalf("expected clone to validate even rule, got %v", err.Rules())
+	} else if msg := err.Errors()["number"]["even"]; msg != "number must be even" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+
+	if err := clone.Var("en", "name", "john doe", "username"); !err.IsFailedOn("name", "username") {
+		t.Fatal("expected clone to keep aliases of original")
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected original to lack even rule")
+			}
+		}()
+		v.Var("en", "number", 3, "even")
+	}()
+}
+
+func TestCloneEngineFactory(t *testing.T) {
+	newEngine := func() *validator.Validate {
+		engine := validator.New(validator.WithRequiredStructEnabled())
+		engine.SetTagName("binding")
+		return engine
+	}
+	v := govalidator.NewValidator(newEngine(), govalidator.WithTranslator(goi18n.NewTranslator("en", language.English), ""))
+	v.AddTranslation("en", "required", "{field} is required")
+
+	type Input struct {
+		Name string `binding:"required"`
+	}
+	clone := v.Clone(newEngine)
+	if err := clone.Struct("en", Input{}); !err.IsFailedOn("Name", "required") {
+		t.Fatalf("expected clone to keep engine tag name, got %v", err.Rules())
+	}
+}
+
+func TestCloneBoundRules(t *testing.T) {
+	translator := goi18n.NewTranslator("en", language.English)
+	translator.AddLocale("fa", nil)
+	v := govalidator.NewValidator(
+		validator.New(),
+		govalidator.WithTranslator(translator, ""),
+		govalidator.WithListValidator(nil),
+		govalidator.WithKnownLocaleValidator(nil),
+	)
+	v.AddTranslation("en", "is_valid", "{field} must be valid")
+
+	t.Run("List", func(t *testing.T) {
+		clone := v.Clone(nil)
+		clone.AddValidation("only_clone", func(fl validator.FieldLevel) bool {
+			return fl.Field().String() != "bad"
+		})
+
+		if err := clone.Var("en", "tags", "good, ok", "list=0x2C:only_clone"); err.HasError() {
+			t.Fatalf("expected no errors, got %v", err)
+		}
+		err := clone.Var("en", "tags", "good, bad", "list=0x2C:only_clone")
+		if msg := err.Errors()["tags"]["list"]; msg != "tags contains an invalid item: bad" {
+			t.Fatalf("unexpected message %q", msg)
+		}
+	})
+
+	t.Run("KnownLocale", func(t *testing.T) {
+		clone := v.Clone(nil)
+		clone.AddTranslation("fa", "is_valid", "{field} معتبر نیست")
+
+		if err := clone.Var("en", "locale", "fa", "known_locale"); err.HasError() {
+			t.Fatalf("expected clone locale to be known, got %v", err)
+		}
+		err := v.Var("en", "locale", "fa", "known_locale")
+		if msg := err.Errors()["locale"]["known_locale"]; msg != "Must be one of the supported locales: en" {
+			t.Fatalf("unexpected original message %q", msg)
+		}
+	})
+}
+
+func TestStructInputErrors(t *testing.T) {
+	type User struct {
+		Name string `validate:"required"`
+	}
+
+	v := newTestValidator()
+	var nilUser *User
+	tests := []struct {
+		name  string
+		value any
+		want  error
+	}{
+		{"Nil", nil, govalidator.ErrNilValue},
+		{"NilPointer", nilUser, govalidator.ErrNilValue},
+		{"Int", 10, govalidator.ErrNotAStruct},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := v.Struct("en", tt.value); !errors.Is(err.InternalError(), tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err.InternalError())
+			}
+			if err := v.StructPartial("en", tt.value, "Name"); !errors.Is(err.InternalError(), tt.want) {
+				t.Fatalf("expected %v from StructPartial, got %v", tt.want, err.InternalError())
+			}
+		})
+	}
+
+	t.Run("Pointer", func(t *testing.T) {
+		err := v.Struct("en", &User{})
+		if err.HasInternalError() || !err.IsFailedOn("Name", "required") {
+			t.Fatalf("expected required error for pointer struct, got %v", err)
+		}
+	})
+}
+
+func TestStructFiltered(t *testing.T) {
+	type Address struct {
+		City   string `validate:"required"`
+		Street string `validate:"required"`
+	}
+	type User struct {
+		Name    string `validate:"required"`
+		Address Address
+	}
+
+	v := newTestValidator()
+	err := v.StructFiltered("en", User{}, func(ns []byte) bool {
+		return !bytes.HasPrefix(ns, []byte("User.Address"))
+	})
+	if err.IsFailed("Name") {
+		t.Fatal("expected Name to be filtered")
+	} else if !err.IsFailedOn("City", "required") || !err.IsFailedOn("Street", "required") {
+		t.Fatalf("expected address errors, got %v", err.Rules())
+	}
+
+	if err := v.StructFiltered("en", 10, func([]byte) bool { return false }); !errors.Is(err.InternalError(), govalidator.ErrNotAStruct) {
+		t.Fatalf("expected ErrNotAStruct, got %v", err.InternalError())
+	}
+}
+
+func TestVars(t *testing.T) {
+	v := newTestValidator()
+	v.AddTranslation("", "numeric", "{field} must be numeric")
+
+	err := v.Vars("en", []govalidator.VarItem{
+		{Name: "page", Value: "2", Rules: "required,numeric"},
+		{Name: "limit", Value: "ten", Rules: "required,numeric"},
+		{Name: "sort", Value: "name", Rules: "oneof=name date"},
+	})
+	if len(err.Errors()) != 1 || !err.IsFailedOn("limit", "numeric") {
+		t.Fatalf("expected only limit error, got %v", err.Rules())
+	} else if msg := err.Errors()["limit"]["numeric"]; msg != "limit must be numeric" {
+		t.Fatalf("unexpected message %q", msg)
+	} else if err.Details()["limit"]["numeric"].Value != "ten" {
+		t.Fatalf("unexpected detail %v", err.Details()["limit"]["numeric"])
+	}
+
+	raw := govalidator.NewValidator(validator.New()).Vars("", []govalidator.VarItem{
+		{Name: "page", Value: "x", Rules: "numeric"},
+		{Name: "limit", Value: "y", Rules: "numeric"},
+	})
+	if !raw.IsFailed("page") || !raw.IsFailed("limit") {
+		t.Fatalf("expected errors keyed by name without translator, got %v", raw.Rules())
+	}
+}
+
+func TestFieldNames(t *testing.T) {
+	type Address struct {
+		City string `json:"city" validate:"required"`
+	}
+	type User struct {
+		Email   string  `json:"email_address" validate:"required,email"`
+		Address Address `json:"address"`
+	}
+
+	v := newTestValidator(govalidator.WithTagResolver("json"))
+	err := v.Struct("en", User{Email: "invalid"})
+	if names := err.FieldNames(); len(names) != 2 || names["email_address"] != "Email" || names["city"] != "City" {
+		t.Fatalf("unexpected field names %v", names)
+	}
+
+	v = newTestValidator(govalidator.WithTagResolver("json"), govalidator.WithErrorKeyMode(govalidator.KeyNamespace))
+	err = v.Struct("en", User{Email: "invalid"})
+	if names := err.FieldNames(); names["email_address"] != "Email" || names["address.city"] != "City" {
+		t.Fatalf("unexpected namespace field names %v", names)
+	} else if names := err.Only("email_address").FieldNames(); len(names) != 1 {
+		t.Fatalf("expected filtered field names, got %v", names)
+	}
+}
+
+func TestInterfaceTranslationDisabled(t *testing.T) {
+	v := newTestValidator(govalidator.WithInterfaceTranslation(false))
+	v.AddTranslation("", "min", "{field} must be at least {param}")
+	v.AddTranslation("", "required", "{field} is required")
+
+	for name, v := range map[string]govalidator.Validator{"Original": v, "Clone": v.Clone(nil)} {
+		err := v.Struct("en", ageForm{Age: 12})
+		if msg := err.Errors()["Age"]["min"]; msg != "Age must be at least 18" {
+			t.Fatalf("%s: unexpected message %q", name, msg)
+		} else if msg := err.Errors()["Name"]["required"]; msg != "Name is required" {
+			t.Fatalf("%s: unexpected message %q", name, msg)
+		}
+	}
+}
+
+func BenchmarkInterfaceTranslation(b *testing.B) {
+	for _, enabled := range []bool{true, false} {
+		b.Run(fmt.Sprintf("enabled=%t", enabled), func(b *testing.B) {
+			v := newTestValidator(govalidator.WithInterfaceTranslation(enabled))
+			v.AddTranslation("", "min", "{field} must be at least {param}")
+			v.AddTranslation("", "required", "{field} is required")
+			form := ageForm{Age: 12}
+
+			b.ReportAllocs()
+			for range b.N {
+				v.Struct("en", form)
+			}
+		})
+	}
+}
+
+func TestInterfaceFields(t *testing.T) {
+	type Payment struct {
+		Amount int    `validate:"min=1" msg:"min=Amount must be positive"`
+		Method string `validate:"required"`
+	}
+	type Event struct {
+		Type    string `validate:"required"`
+		Payload any
+		Items   []any `validate:"dive"`
+	}
+
+	v := newTestValidator(govalidator.WithErrorKeyMode(govalidator.KeyNamespace))
+	if err := v.Struct("en", Event{Type: "payment", Payload: Payment{Amount: 10, Method: "card"}}); err.HasError() {
+		t.Fatalf("expected no errors, got %v", err)
+	}
+
+	err := v.Struct("en", Event{Type: "payment", Payload: &Payment{Method: "card"}, Items: []any{Payment{Amount: 5}}})
+	if msg := err.Errors()["Payload.Amount"]["min"]; msg != "Amount must be positive" {
+		t.Fatalf("unexpected message %q", msg)
+	} else if !err.IsFailedOn("Items[0].Method", "required") {
+		t.Fatalf("expected nested item error, got %v", err.Rules())
+	}
+
+	if err := v.Struct("en", Event{Type: "payment", Payload: "raw"}); err.HasError() {
+		t.Fatalf("expected non struct payload to be skipped, got %v", err)
+	}
 }